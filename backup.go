@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-funcards/board-service/internal/snapshot"
+	"github.com/rs/zerolog"
+	"io"
+	"text/tabwriter"
+	"time"
+)
+
+// backup runs `board-service backup <file>` and writes the manifest of the
+// snapshot to out.
+func backup(ctx context.Context, storages snapshot.Storages, driver, path string, out io.Writer, log zerolog.Logger) error {
+	if path == "" {
+		return errors.New("backup file is required")
+	}
+
+	m, err := snapshot.Write(ctx, storages, driver, path, log)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "snapshot %s written to %s, checksum in %s.sha256\n", m.CreatedAt.Format(time.RFC3339), path, path)
+	return manifest(out, m)
+}
+
+// restore runs `board-service restore <file>`, loading a snapshot into an
+// empty database, and writes what was restored to out. With `restore verify
+// <file>` it only checks the snapshot.
+func restore(ctx context.Context, storages snapshot.Storages, args []string, out io.Writer) error {
+	verify := len(args) > 0 && args[0] == "verify"
+	if verify {
+		args = args[1:]
+	}
+	if len(args) == 0 || args[0] == "" {
+		return errors.New("backup file is required")
+	}
+	path := args[0]
+
+	if verify {
+		m, checked, err := snapshot.Verify(path)
+		if err != nil {
+			return err
+		}
+		if !checked {
+			fmt.Fprintf(out, "%s.sha256 not found, entries checked against the manifest only\n", path)
+		}
+		fmt.Fprintf(out, "snapshot %s of %s is valid\n", m.CreatedAt.Format(time.RFC3339), path)
+		return manifest(out, m)
+	}
+
+	m, err := snapshot.Restore(ctx, storages, path)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "snapshot %s restored from %s\n", m.CreatedAt.Format(time.RFC3339), path)
+	return manifest(out, m)
+}
+
+func manifest(out io.Writer, m snapshot.Manifest) error {
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+
+	fmt.Fprintln(w, "FILE\tENTRIES\tSIZE\tSHA256")
+	for _, file := range m.Files {
+		fmt.Fprintf(w, "%s\t%d\t%d\t%s\n", file.Name, file.Entries, file.Size, file.SHA256)
+	}
+
+	return w.Flush()
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-funcards/board-service/proto/v1"
+	"github.com/spf13/cobra"
+)
+
+func (a *app) membersCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "members",
+		Short: "Add or remove members of a board",
+	}
+
+	var roles []string
+	add := a.dryRunFlag(&cobra.Command{
+		Use:   "add <board-id> <member-id>...",
+		Short: "Add members to a board, or change their roles",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			in := &v1.UpdateBoardRequest{BoardId: args[0]}
+			for _, id := range args[1:] {
+				in.Members = append(in.Members, &v1.UpdateBoardRequest_Member{MemberId: id, Roles: roles})
+			}
+			return a.mutate(cmd, in, func(ctx context.Context) error {
+				_, err := a.client.UpdateBoard(ctx, in)
+				return err
+			})
+		},
+	})
+	add.Flags().StringSliceVar(&roles, "role", nil, "roles of the members")
+	_ = add.MarkFlagRequired("role")
+
+	remove := a.dryRunFlag(&cobra.Command{
+		Use:   "remove <board-id> <member-id>...",
+		Short: "Remove members from a board",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			in := &v1.UpdateBoardRequest{BoardId: args[0]}
+			for _, id := range args[1:] {
+				in.Members = append(in.Members, &v1.UpdateBoardRequest_Member{MemberId: id, Delete: true})
+			}
+			return a.mutate(cmd, in, func(ctx context.Context) error {
+				_, err := a.client.UpdateBoard(ctx, in)
+				return err
+			})
+		},
+	})
+
+	cmd.AddCommand(add, remove)
+	return cmd
+}
+
+func (a *app) transferCommand() *cobra.Command {
+	return a.dryRunFlag(&cobra.Command{
+		Use:   "transfer <board-id> <owner-id>",
+		Short: "Transfer a board to another owner",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			in := &v1.TransferBoardRequest{BoardId: args[0], OwnerId: args[1]}
+			return a.mutate(cmd, in, func(ctx context.Context) error {
+				_, err := a.client.TransferBoard(ctx, in)
+				return err
+			})
+		},
+	})
+}
+
+func (a *app) moveCommand() *cobra.Command {
+	return a.dryRunFlag(&cobra.Command{
+		Use:   "move <board-id> <workspace-id>",
+		Short: "Move a board to another workspace",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			in := &v1.MoveBoardRequest{BoardId: args[0], ToWorkspaceId: args[1]}
+			return a.mutate(cmd, in, func(ctx context.Context) error {
+				_, err := a.client.MoveBoard(ctx, in)
+				return err
+			})
+		},
+	})
+}
+
+func (a *app) restoreCommand() *cobra.Command {
+	var version uint64
+
+	cmd := a.dryRunFlag(&cobra.Command{
+		Use:   "restore <board-id>",
+		Short: "Restore a board, deleted or not, to a version",
+		Long: "Restore a board to a version, the latest one unless --version is given.\n" +
+			"A deleted board is recreated from the version, as it was before the delete.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			in := &v1.RevertBoardRequest{BoardId: args[0], Version: version}
+			if in.Version == 0 {
+				latest, err := a.latestVersion(cmd, in.BoardId)
+				if err != nil {
+					return err
+				}
+				in.Version = latest
+			}
+			return a.mutate(cmd, in, func(ctx context.Context) error {
+				_, err := a.client.RevertBoard(ctx, in)
+				return err
+			})
+		},
+	})
+
+	cmd.Flags().Uint64Var(&version, "version", 0, "version to restore, the latest one by default")
+	return cmd
+}
+
+func (a *app) latestVersion(cmd *cobra.Command, boardID string) (uint64, error) {
+	ctx, cancel := a.context(cmd)
+	defer cancel()
+
+	// versions are listed newest first
+	res, err := a.client.ListBoardVersions(ctx, &v1.BoardVersionsRequest{BoardId: boardID, PageSize: 1})
+	if err != nil {
+		return 0, err
+	}
+	if len(res.GetVersions()) == 0 {
+		return 0, fmt.Errorf("board %s has no versions to restore", boardID)
+	}
+	return res.GetVersions()[0].GetVersion(), nil
+}
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"encoding/json"
+	"github.com/go-funcards/board-service/proto/v1"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"strings"
+)
+
+// maxPageSize is the largest page the service accepts.
+const maxPageSize = 1000
+
+func (a *app) listCommand() *cobra.Command {
+	in := &v1.BoardsRequest{}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List boards, newest first",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx, cancel := a.context(cmd)
+			defer cancel()
+
+			res, err := a.client.GetBoards(ctx, in)
+			if err != nil {
+				return err
+			}
+			return a.printBoards(cmd.OutOrStdout(), res)
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&in.OwnerIds, "owner", nil, "owner ids to filter by")
+	cmd.Flags().StringSliceVar(&in.MemberIds, "member", nil, "member ids to filter by, members of their workspaces included")
+	cmd.Flags().StringSliceVar(&in.WorkspaceIds, "in", nil, "workspace ids to filter by")
+	cmd.Flags().Uint64Var(&in.PageIndex, "page", 0, "page index")
+	cmd.Flags().Uint32Var(&in.PageSize, "page-size", 50, "page size")
+	return cmd
+}
+
+func (a *app) searchCommand() *cobra.Command {
+	in := &v1.BoardsRequest{PageSize: maxPageSize}
+	var limit int
+
+	cmd := &cobra.Command{
+		Use:   "search <text>",
+		Short: "Find boards whose name or metadata contains text",
+		Long: "Find boards whose name or metadata contains text, ignoring case.\n" +
+			"The service has no text search, so the boards are scanned page by page;\n" +
+			"narrow the scan with --owner or --member on large deployments.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			text := strings.ToLower(args[0])
+			found := &v1.BoardsResponse{}
+
+			for {
+				ctx, cancel := a.context(cmd)
+				res, err := a.client.GetBoards(ctx, in)
+				cancel()
+				if err != nil {
+					return err
+				}
+
+				for _, item := range res.GetBoards() {
+					if strings.Contains(strings.ToLower(item.GetName()), text) ||
+						strings.Contains(strings.ToLower(item.GetMetadata()), text) {
+						found.Boards = append(found.Boards, item)
+					}
+				}
+
+				if len(found.Boards) >= limit || len(res.GetBoards()) < int(in.PageSize) {
+					break
+				}
+				in.PageIndex++
+			}
+
+			if len(found.Boards) > limit {
+				found.Boards = found.Boards[:limit]
+			}
+			found.Total = uint64(len(found.Boards))
+			return a.printBoards(cmd.OutOrStdout(), found)
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&in.OwnerIds, "owner", nil, "owner ids to filter by")
+	cmd.Flags().StringSliceVar(&in.MemberIds, "member", nil, "member ids to filter by")
+	cmd.Flags().IntVar(&limit, "limit", 50, "maximum number of boards to return")
+	return cmd
+}
+
+func (a *app) showCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "show <board-id>",
+		Short: "Show a board and its members",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := a.context(cmd)
+			defer cancel()
+
+			res, err := a.client.GetBoard(ctx, &v1.BoardRequest{BoardId: args[0]})
+			if err != nil {
+				return err
+			}
+			return a.printBoard(cmd.OutOrStdout(), res)
+		},
+	}
+}
+
+// export is a board with its history, in the JSON mapping of the API.
+type export struct {
+	Board    json.RawMessage   `json:"board"`
+	Versions []json.RawMessage `json:"versions"`
+	Audit    []json.RawMessage `json:"audit"`
+}
+
+func (a *app) exportCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "export <board-id>",
+		Short: "Export a board with its versions and audit log as JSON",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := a.context(cmd)
+			defer cancel()
+
+			item, err := a.client.GetBoard(ctx, &v1.BoardRequest{BoardId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			var data export
+			if data.Board, err = protojson.Marshal(item); err != nil {
+				return err
+			}
+
+			versions := &v1.BoardVersionsRequest{BoardId: args[0], PageSize: maxPageSize}
+			for {
+				res, err := a.client.ListBoardVersions(ctx, versions)
+				if err != nil {
+					return err
+				}
+				if data.Versions, err = appendJSON(data.Versions, res.GetVersions()); err != nil {
+					return err
+				}
+				if uint64(len(data.Versions)) >= res.GetTotal() || len(res.GetVersions()) == 0 {
+					break
+				}
+				versions.PageIndex++
+			}
+
+			audit := &v1.BoardAuditRequest{BoardId: args[0], PageSize: maxPageSize}
+			for {
+				res, err := a.client.ListBoardAudit(ctx, audit)
+				if err != nil {
+					return err
+				}
+				if data.Audit, err = appendJSON(data.Audit, res.GetEntries()); err != nil {
+					return err
+				}
+				if res.GetNextPageToken() == "" {
+					break
+				}
+				audit.PageToken = res.GetNextPageToken()
+			}
+
+			// an export is always JSON, a table cannot hold the history
+			return a.printJSON(cmd.OutOrStdout(), data)
+		},
+	}
+}
+
+func appendJSON[T proto.Message](dst []json.RawMessage, items []T) ([]json.RawMessage, error) {
+	for _, item := range items {
+		data, err := protojson.Marshal(item)
+		if err != nil {
+			return nil, err
+		}
+		dst = append(dst, data)
+	}
+	return dst, nil
+}
@@ -0,0 +1,220 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/go-funcards/board-service/internal/board"
+	"github.com/go-funcards/board-service/proto/v1"
+	"github.com/spf13/cobra"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+)
+
+const loadChunkSize = 64 << 10
+
+func (a *app) dumpCommand() *cobra.Command {
+	in := &v1.ExportBoardsRequest{}
+	var file string
+
+	cmd := &cobra.Command{
+		Use:   "dump",
+		Short: "Export boards as JSON Lines",
+		Long: "Export boards as JSON Lines, a header line followed by a line per board.\n" +
+			"--timeout bounds the whole export, set it to 0 for large ones.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			out := cmd.OutOrStdout()
+			if file != "" && file != "-" {
+				f, err := os.Create(file)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				out = f
+			}
+
+			ctx, cancel := a.context(cmd)
+			defer cancel()
+
+			stream, err := a.client.ExportBoards(ctx, in)
+			if err != nil {
+				return err
+			}
+			for {
+				chunk, err := stream.Recv()
+				if err == io.EOF {
+					return nil
+				}
+				if err != nil {
+					return err
+				}
+				if _, err = out.Write(chunk.GetData()); err != nil {
+					return err
+				}
+			}
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&in.BoardIds, "board", nil, "board ids to export")
+	cmd.Flags().StringSliceVar(&in.OwnerIds, "owner", nil, "owner ids to filter by")
+	cmd.Flags().StringSliceVar(&in.MemberIds, "member", nil, "member ids to filter by")
+	cmd.Flags().StringVarP(&file, "file", "f", "-", "file to write, - for stdout")
+	return cmd
+}
+
+// loadPlan is what a dry run of load reports.
+type loadPlan struct {
+	Header    board.ExportHeader `json:"header"`
+	Boards    int                `json:"boards"`
+	Mode      string             `json:"mode"`
+	RemapIDs  bool               `json:"remap_ids"`
+	Workspace string             `json:"workspace,omitempty"`
+}
+
+func (a *app) loadCommand() *cobra.Command {
+	var mode, into string
+	var remap bool
+
+	cmd := a.dryRunFlag(&cobra.Command{
+		Use:   "load <file>",
+		Short: "Import boards exported by dump",
+		Long: "Import boards exported by dump, - reads stdin. Boards that already exist are\n" +
+			"skipped, overwritten or stop the import by --mode. --remap-ids gives every\n" +
+			"board a new id and prints the mapping. Boards go to the workspace they were\n" +
+			"exported from, unless --into names another.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			value, ok := v1.ImportMode_value["IMPORT_MODE_"+strings.ToUpper(mode)]
+			if !ok {
+				return fmt.Errorf("unknown mode %q, want skip, overwrite or fail", mode)
+			}
+
+			r := cmd.InOrStdin()
+			if args[0] != "-" {
+				f, err := os.Open(args[0])
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				r = f
+			}
+
+			var plan *loadPlan
+			if a.dryRun {
+				var err error
+				if plan, err = planLoad(r); err != nil {
+					return err
+				}
+				plan.Mode, plan.RemapIDs, plan.Workspace = mode, remap, into
+			}
+
+			var res *v1.ImportBoardsResponse
+			err := a.mutate(cmd, plan, func(ctx context.Context) error {
+				var err error
+				res, err = a.load(ctx, r, &v1.ImportChunk{Mode: v1.ImportMode(value), RemapIds: remap, WorkspaceId: into})
+				return err
+			})
+			if err != nil || res == nil {
+				return err
+			}
+			return a.printImport(cmd.OutOrStdout(), res)
+		},
+	})
+
+	cmd.Flags().StringVar(&mode, "mode", "skip", "what to do with existing boards, skip, overwrite or fail")
+	cmd.Flags().BoolVar(&remap, "remap-ids", false, "give every board a new id")
+	cmd.Flags().StringVar(&into, "into", "", "workspace to import every board into")
+	return cmd
+}
+
+// load streams r in chunks, the first one carrying the options.
+func (a *app) load(ctx context.Context, r io.Reader, first *v1.ImportChunk) (*v1.ImportBoardsResponse, error) {
+	stream, err := a.client.ImportBoards(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	chunk := first
+	buf := make([]byte, loadChunkSize)
+	for {
+		n, err := io.ReadFull(r, buf)
+		if err == io.EOF {
+			break
+		}
+		if err != nil && err != io.ErrUnexpectedEOF {
+			return nil, err
+		}
+
+		chunk.Data = buf[:n]
+		if err = stream.Send(chunk); err != nil {
+			if err == io.EOF {
+				// the server stopped reading, CloseAndRecv tells why
+				break
+			}
+			return nil, err
+		}
+		chunk = &v1.ImportChunk{}
+	}
+
+	return stream.CloseAndRecv()
+}
+
+func planLoad(r io.Reader) (*loadPlan, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 4<<20)
+
+	plan := &loadPlan{}
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("import is empty")
+	}
+	if err := json.Unmarshal(scanner.Bytes(), &plan.Header); err != nil {
+		return nil, fmt.Errorf("line 1: %w", err)
+	}
+	if plan.Header.Format != board.ExportFormat || plan.Header.Version > board.ExportVersion {
+		return nil, fmt.Errorf("line 1: %s version %d cannot be imported", plan.Header.Format, plan.Header.Version)
+	}
+
+	for scanner.Scan() {
+		if len(scanner.Bytes()) > 0 {
+			plan.Boards++
+		}
+	}
+	return plan, scanner.Err()
+}
+
+func (a *app) printImport(w io.Writer, res *v1.ImportBoardsResponse) error {
+	if a.output == outputJSON {
+		return a.printJSON(w, res)
+	}
+
+	fmt.Fprintf(w, "%d created, %d overwritten, %d skipped, %d failed\n",
+		res.GetCreated(), res.GetOverwritten(), res.GetSkipped(), len(res.GetFailures()))
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	if len(res.GetFailures()) > 0 {
+		fmt.Fprintln(tw, "\nBOARD ID\tCODE\tMESSAGE")
+		for _, f := range res.GetFailures() {
+			fmt.Fprintf(tw, "%s\t%d\t%s\n", f.GetBoardId(), f.GetCode(), f.GetMessage())
+		}
+	}
+	if len(res.GetRemappedIds()) > 0 {
+		fmt.Fprintln(tw, "\nBOARD ID\tIMPORTED AS")
+		ids := make([]string, 0, len(res.GetRemappedIds()))
+		for id := range res.GetRemappedIds() {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+		for _, id := range ids {
+			fmt.Fprintf(tw, "%s\t%s\n", id, res.GetRemappedIds()[id])
+		}
+	}
+	return tw.Flush()
+}
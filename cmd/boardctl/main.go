@@ -0,0 +1,163 @@
+// Command boardctl operates on boards through the gRPC API of the board
+// service, for support work that used to be done in the mongo shell.
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"github.com/go-funcards/board-service/internal/board"
+	"github.com/go-funcards/board-service/proto/v1"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	outputJSON  = "json"
+	outputTable = "table"
+)
+
+type app struct {
+	addr       string
+	output     string
+	actor      string
+	token      string
+	workspaces []string
+	timeout    time.Duration
+	dryRun     bool
+
+	conn   *grpc.ClientConn
+	client v1.BoardClient
+}
+
+func main() {
+	a := &app{}
+
+	root := &cobra.Command{
+		Use:           "boardctl",
+		Short:         "Operate on boards of the board service",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		PersistentPreRunE: func(cmd *cobra.Command, _ []string) error {
+			if a.output != outputJSON && a.output != outputTable {
+				return fmt.Errorf("unknown output %q, want %s or %s", a.output, outputJSON, outputTable)
+			}
+			return a.dial()
+		},
+		PersistentPostRun: func(*cobra.Command, []string) {
+			if a.conn != nil {
+				_ = a.conn.Close()
+			}
+		},
+	}
+
+	flags := root.PersistentFlags()
+	flags.StringVar(&a.addr, "addr", env("BOARDCTL_ADDR", "localhost:80"), "board service gRPC address")
+	flags.StringVarP(&a.output, "output", "o", outputTable, "output format, json or table")
+	flags.StringVar(&a.actor, "actor", os.Getenv("BOARDCTL_ACTOR"), "actor id recorded in the audit log of changes")
+	flags.StringVar(&a.token, "token", os.Getenv("BOARDCTL_TOKEN"), "bearer JWT sent with the calls, its workspace claim is the tenant")
+	flags.StringSliceVar(&a.workspaces, "workspace", splitEnv("BOARDCTL_WORKSPACE"), "workspaces to act in, the tenant of the calls or some of those of the token")
+	flags.DurationVar(&a.timeout, "timeout", 30*time.Second, "timeout of each call, 0 for none")
+
+	root.AddCommand(
+		a.listCommand(),
+		a.searchCommand(),
+		a.showCommand(),
+		a.exportCommand(),
+		a.membersCommand(),
+		a.transferCommand(),
+		a.moveCommand(),
+		a.restoreCommand(),
+		a.dumpCommand(),
+		a.loadCommand(),
+		a.workspacesCommand(),
+	)
+
+	if err := root.Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, "boardctl:", err)
+		os.Exit(1)
+	}
+}
+
+func (a *app) dial() error {
+	conn, err := grpc.Dial(a.addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return err
+	}
+	a.conn = conn
+	a.client = v1.NewBoardClient(conn)
+	return nil
+}
+
+// context bounds a call by the timeout and sends the actor, token and
+// workspaces along.
+func (a *app) context(cmd *cobra.Command) (context.Context, context.CancelFunc) {
+	var ctx context.Context
+	var cancel context.CancelFunc
+	if a.timeout > 0 {
+		ctx, cancel = context.WithTimeout(cmd.Context(), a.timeout)
+	} else {
+		ctx, cancel = context.WithCancel(cmd.Context())
+	}
+	if a.actor != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, board.MetadataActorID, a.actor)
+	}
+	if a.token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, board.MetadataAuthorization, "Bearer "+a.token)
+	}
+	if len(a.workspaces) > 0 {
+		ctx = metadata.AppendToOutgoingContext(ctx, board.MetadataWorkspaceID, strings.Join(a.workspaces, ","))
+	}
+	return ctx, cancel
+}
+
+// mutate sends a change under a request id of its own, which the audit log
+// records next to the actor. With --dry-run it prints the request instead.
+func (a *app) mutate(cmd *cobra.Command, in any, call func(ctx context.Context) error) error {
+	if a.dryRun {
+		fmt.Fprintln(cmd.ErrOrStderr(), "dry run, not sent:")
+		return a.printJSON(cmd.OutOrStdout(), in)
+	}
+
+	ctx, cancel := a.context(cmd)
+	defer cancel()
+
+	id := make([]byte, 8)
+	if _, err := rand.Read(id); err != nil {
+		return err
+	}
+	requestID := "boardctl-" + hex.EncodeToString(id)
+
+	ctx = metadata.AppendToOutgoingContext(ctx, board.MetadataRequestID, requestID)
+	if err := call(ctx); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(cmd.ErrOrStderr(), "done, request id", requestID)
+	return nil
+}
+
+func (a *app) dryRunFlag(cmd *cobra.Command) *cobra.Command {
+	cmd.Flags().BoolVar(&a.dryRun, "dry-run", false, "print the request instead of sending it")
+	return cmd
+}
+
+func env(key, fallback string) string {
+	if value, ok := os.LookupEnv(key); ok {
+		return value
+	}
+	return fallback
+}
+
+func splitEnv(key string) []string {
+	if value := os.Getenv(key); len(value) > 0 {
+		return strings.Split(value, ",")
+	}
+	return nil
+}
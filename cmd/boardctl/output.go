@@ -0,0 +1,126 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/go-funcards/board-service/proto/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+var marshaler = protojson.MarshalOptions{Multiline: true, Indent: "  "}
+
+// printJSON writes protobuf messages with their JSON mapping, so the output
+// matches the HTTP gateway, and anything else with encoding/json.
+func (a *app) printJSON(w io.Writer, v any) error {
+	var data []byte
+	var err error
+	if m, ok := v.(proto.Message); ok {
+		data, err = marshaler.Marshal(m)
+	} else {
+		data, err = json.MarshalIndent(v, "", "  ")
+	}
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, string(data))
+	return err
+}
+
+func (a *app) printBoards(w io.Writer, res *v1.BoardsResponse) error {
+	if a.output == outputJSON {
+		return a.printJSON(w, res)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "BOARD ID\tOWNER ID\tNAME\tMEMBERS\tVERSION\tCREATED AT")
+	for _, item := range res.GetBoards() {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%d\t%d\t%s\n",
+			item.GetBoardId(),
+			item.GetOwnerId(),
+			item.GetName(),
+			len(item.GetMembers()),
+			item.GetVersion(),
+			item.GetCreatedAt().AsTime().Format(time.RFC3339),
+		)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	_, err := fmt.Fprintf(w, "%d of %d boards\n", len(res.GetBoards()), res.GetTotal())
+	return err
+}
+
+func (a *app) printBoard(w io.Writer, item *v1.BoardsResponse_Board) error {
+	if a.output == outputJSON {
+		return a.printJSON(w, item)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "Board ID:\t%s\n", item.GetBoardId())
+	fmt.Fprintf(tw, "Workspace ID:\t%s\n", item.GetWorkspaceId())
+	fmt.Fprintf(tw, "Owner ID:\t%s\n", item.GetOwnerId())
+	fmt.Fprintf(tw, "Name:\t%s\n", item.GetName())
+	fmt.Fprintf(tw, "Version:\t%d\n", item.GetVersion())
+	fmt.Fprintf(tw, "Created at:\t%s\n", item.GetCreatedAt().AsTime().Format(time.RFC3339))
+	if item.GetMetadata() != "" {
+		fmt.Fprintf(tw, "Metadata:\t%s\n", item.GetMetadata())
+	}
+	fmt.Fprintln(tw)
+	fmt.Fprintln(tw, "MEMBER ID\tROLES\tFROM")
+	for _, m := range item.GetMembers() {
+		from := "board"
+		if m.GetInherited() {
+			from = "workspace"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", m.GetMemberId(), strings.Join(m.GetRoles(), ","), from)
+	}
+	return tw.Flush()
+}
+
+func (a *app) printWorkspaces(w io.Writer, res *v1.WorkspacesResponse) error {
+	if a.output == outputJSON {
+		return a.printJSON(w, res)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "WORKSPACE ID\tOWNER ID\tNAME\tMEMBERS\tCREATED AT")
+	for _, item := range res.GetWorkspaces() {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%d\t%s\n",
+			item.GetWorkspaceId(),
+			item.GetOwnerId(),
+			item.GetName(),
+			len(item.GetMembers()),
+			item.GetCreatedAt().AsTime().Format(time.RFC3339),
+		)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	_, err := fmt.Fprintf(w, "%d of %d workspaces\n", len(res.GetWorkspaces()), res.GetTotal())
+	return err
+}
+
+func (a *app) printWorkspace(w io.Writer, item *v1.WorkspacesResponse_Workspace) error {
+	if a.output == outputJSON {
+		return a.printJSON(w, item)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "Workspace ID:\t%s\n", item.GetWorkspaceId())
+	fmt.Fprintf(tw, "Owner ID:\t%s\n", item.GetOwnerId())
+	fmt.Fprintf(tw, "Name:\t%s\n", item.GetName())
+	fmt.Fprintf(tw, "Created at:\t%s\n", item.GetCreatedAt().AsTime().Format(time.RFC3339))
+	fmt.Fprintln(tw)
+	fmt.Fprintln(tw, "MEMBER ID\tROLES")
+	for _, m := range item.GetMembers() {
+		fmt.Fprintf(tw, "%s\t%s\n", m.GetMemberId(), strings.Join(m.GetRoles(), ","))
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"context"
+	"github.com/go-funcards/board-service/proto/v1"
+	"github.com/spf13/cobra"
+)
+
+func (a *app) workspacesCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "workspaces",
+		Short: "List and manage workspaces and their members",
+	}
+
+	filter := &v1.WorkspacesRequest{}
+	list := &cobra.Command{
+		Use:   "list",
+		Short: "List workspaces, newest first",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx, cancel := a.context(cmd)
+			defer cancel()
+
+			res, err := a.client.GetWorkspaces(ctx, filter)
+			if err != nil {
+				return err
+			}
+			return a.printWorkspaces(cmd.OutOrStdout(), res)
+		},
+	}
+	list.Flags().StringSliceVar(&filter.OwnerIds, "owner", nil, "owner ids to filter by")
+	list.Flags().StringSliceVar(&filter.MemberIds, "member", nil, "member ids to filter by")
+	list.Flags().Uint64Var(&filter.PageIndex, "page", 0, "page index")
+	list.Flags().Uint32Var(&filter.PageSize, "page-size", 50, "page size")
+
+	show := &cobra.Command{
+		Use:   "show <workspace-id>",
+		Short: "Show a workspace and its members",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := a.context(cmd)
+			defer cancel()
+
+			res, err := a.client.GetWorkspace(ctx, &v1.WorkspaceRequest{WorkspaceId: args[0]})
+			if err != nil {
+				return err
+			}
+			return a.printWorkspace(cmd.OutOrStdout(), res)
+		},
+	}
+
+	created := &v1.CreateWorkspaceRequest{}
+	create := a.dryRunFlag(&cobra.Command{
+		Use:   "create <workspace-id>",
+		Short: "Create a workspace",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			created.WorkspaceId = args[0]
+			return a.mutate(cmd, created, func(ctx context.Context) error {
+				_, err := a.client.CreateWorkspace(ctx, created)
+				return err
+			})
+		},
+	})
+	create.Flags().StringVar(&created.OwnerId, "owner", "", "owner of the workspace")
+	create.Flags().StringVar(&created.Name, "name", "", "name of the workspace")
+	_ = create.MarkFlagRequired("owner")
+	_ = create.MarkFlagRequired("name")
+
+	rename := a.dryRunFlag(&cobra.Command{
+		Use:   "rename <workspace-id> <name>",
+		Short: "Rename a workspace",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			in := &v1.UpdateWorkspaceRequest{WorkspaceId: args[0], Name: args[1]}
+			return a.mutate(cmd, in, func(ctx context.Context) error {
+				_, err := a.client.UpdateWorkspace(ctx, in)
+				return err
+			})
+		},
+	})
+
+	remove := a.dryRunFlag(&cobra.Command{
+		Use:   "delete <workspace-id>",
+		Short: "Delete a workspace without boards",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			in := &v1.DeleteWorkspaceRequest{WorkspaceId: args[0]}
+			return a.mutate(cmd, in, func(ctx context.Context) error {
+				_, err := a.client.DeleteWorkspace(ctx, in)
+				return err
+			})
+		},
+	})
+
+	cmd.AddCommand(list, show, create, rename, remove, a.workspaceMembersCommand())
+	return cmd
+}
+
+func (a *app) workspaceMembersCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "members",
+		Short: "Add or remove members of a workspace, and so of its boards",
+	}
+
+	var roles []string
+	add := a.dryRunFlag(&cobra.Command{
+		Use:   "add <workspace-id> <member-id>...",
+		Short: "Add members to a workspace, or change their roles",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			in := &v1.UpdateWorkspaceRequest{WorkspaceId: args[0]}
+			for _, id := range args[1:] {
+				in.Members = append(in.Members, &v1.UpdateWorkspaceRequest_Member{MemberId: id, Roles: roles})
+			}
+			return a.mutate(cmd, in, func(ctx context.Context) error {
+				_, err := a.client.UpdateWorkspace(ctx, in)
+				return err
+			})
+		},
+	})
+	add.Flags().StringSliceVar(&roles, "role", nil, "roles of the members")
+	_ = add.MarkFlagRequired("role")
+
+	remove := a.dryRunFlag(&cobra.Command{
+		Use:   "remove <workspace-id> <member-id>...",
+		Short: "Remove members from a workspace",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			in := &v1.UpdateWorkspaceRequest{WorkspaceId: args[0]}
+			for _, id := range args[1:] {
+				in.Members = append(in.Members, &v1.UpdateWorkspaceRequest_Member{MemberId: id, Delete: true})
+			}
+			return a.mutate(cmd, in, func(ctx context.Context) error {
+				_, err := a.client.UpdateWorkspace(ctx, in)
+				return err
+			})
+		},
+	})
+
+	cmd.AddCommand(add, remove)
+	return cmd
+}
@@ -5,5 +5,12 @@ PATH=$PATH:$GOPATH/bin
 
 go install google.golang.org/protobuf/cmd/protoc-gen-go@v1.28
 go install google.golang.org/grpc/cmd/protoc-gen-go-grpc@v1.2
+go install github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-grpc-gateway@v2.15.2
+go install github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2@v2.15.2
 
-protoc -I proto --go_out=proto/v1 --go-grpc_out=proto/v1 proto/v1/board.proto
+protoc -I proto \
+  --go_out=proto/v1 \
+  --go-grpc_out=proto/v1 \
+  --grpc-gateway_out=proto/v1 \
+  --openapiv2_out=proto \
+  proto/v1/board.proto
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-funcards/board-service/internal/board/db"
+	"io"
+	"text/tabwriter"
+)
+
+const (
+	indexesPlan  = "plan"
+	indexesApply = "apply"
+)
+
+// indexes runs `board-service indexes [plan|apply]`, plan being the default
+// mode, and writes the steps reconciling the indexes to out. Indexes are
+// applied under the migration lock, like on startup.
+func indexes(ctx context.Context, migrator *db.Migrator, reconciler *db.IndexReconciler, mode string, out io.Writer) error {
+	var plan []db.IndexAction
+	var err error
+
+	switch mode {
+	case "", indexesPlan:
+		plan, err = reconciler.Plan(ctx)
+	case indexesApply:
+		err = migrator.Locked(ctx, func(ctx context.Context) (err error) {
+			plan, err = reconciler.Apply(ctx)
+			return err
+		})
+	default:
+		return fmt.Errorf("unknown indexes mode %q, expected %s or %s", mode, indexesPlan, indexesApply)
+	}
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	defer w.Flush()
+
+	fmt.Fprintln(w, "COLLECTION\tINDEX\tACTION\tSPEC\tREASON")
+	for _, action := range plan {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", action.Collection, action.Index, action.Action, action.Spec, action.Reason)
+	}
+
+	return nil
+}
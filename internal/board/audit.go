@@ -0,0 +1,195 @@
+package board
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"github.com/go-funcards/board-service/proto/v1"
+	"github.com/go-funcards/slice"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"reflect"
+	"time"
+)
+
+const (
+	MetadataActorID   = "actor-id"
+	MetadataRequestID = "x-request-id"
+)
+
+const (
+	ActionCreate = "create"
+	ActionUpdate = "update"
+	ActionDelete = "delete"
+)
+
+type AuditState struct {
+	WorkspaceID string   `json:"workspace_id,omitempty" bson:"workspace_id,omitempty"`
+	OwnerID     string   `json:"owner_id,omitempty" bson:"owner_id,omitempty"`
+	Name        string   `json:"name,omitempty" bson:"name,omitempty"`
+	Metadata    string   `json:"metadata,omitempty" bson:"metadata,omitempty"`
+	Members     []Member `json:"members,omitempty" bson:"members,omitempty"`
+}
+
+type AuditEntry struct {
+	AuditID     string     `json:"audit_id" bson:"_id,omitempty"`
+	BoardID     string     `json:"board_id" bson:"board_id,omitempty"`
+	WorkspaceID string     `json:"workspace_id,omitempty" bson:"workspace_id,omitempty"`
+	ActorID     string     `json:"actor_id" bson:"actor_id,omitempty"`
+	Action      string     `json:"action" bson:"action,omitempty"`
+	RequestID   string     `json:"request_id" bson:"request_id,omitempty"`
+	Before      AuditState `json:"before" bson:"before"`
+	After       AuditState `json:"after" bson:"after"`
+	CreatedAt   time.Time  `json:"created_at" bson:"created_at,omitempty"`
+}
+
+type AuditFilter struct {
+	BoardID      string    `json:"board_id,omitempty"`
+	WorkspaceIDs []string  `json:"workspace_ids,omitempty"`
+	ActorID      string    `json:"actor_id,omitempty"`
+	From         time.Time `json:"from,omitempty"`
+	To           time.Time `json:"to,omitempty"`
+	AfterID      string    `json:"after_id,omitempty"`
+}
+
+func (e AuditEntry) toProto() *v1.BoardAuditResponse_Entry {
+	return &v1.BoardAuditResponse_Entry{
+		AuditId:     e.AuditID,
+		BoardId:     e.BoardID,
+		WorkspaceId: e.WorkspaceID,
+		ActorId:     e.ActorID,
+		Action:      e.Action,
+		RequestId:   e.RequestID,
+		Before:      e.Before.toProto(),
+		After:       e.After.toProto(),
+		CreatedAt:   timestamppb.New(e.CreatedAt),
+	}
+}
+
+func (s AuditState) toProto() *v1.BoardAuditResponse_Entry_State {
+	return &v1.BoardAuditResponse_Entry_State{
+		WorkspaceId: s.WorkspaceID,
+		OwnerId:     s.OwnerID,
+		Name:        s.Name,
+		Metadata:    s.Metadata,
+		Members: slice.Map(s.Members, func(m Member) *v1.BoardsResponse_Board_Member {
+			return m.toProto()
+		}),
+	}
+}
+
+// CreateAuditEntry records the difference between two versions of a board,
+// either of which is nil when the board is being created or deleted.
+func CreateAuditEntry(ctx context.Context, before, after *Board) AuditEntry {
+	entry := AuditEntry{
+		ActorID:   actorFromContext(ctx),
+		RequestID: fromMetadata(ctx, MetadataRequestID),
+		CreatedAt: time.Now().UTC(),
+	}
+
+	switch {
+	case before == nil:
+		entry.Action = ActionCreate
+		entry.BoardID = after.BoardID
+		entry.WorkspaceID = after.WorkspaceID
+		before = &Board{}
+	case after == nil:
+		entry.Action = ActionDelete
+		entry.BoardID = before.BoardID
+		entry.WorkspaceID = before.WorkspaceID
+		after = &Board{}
+	default:
+		entry.Action = ActionUpdate
+		entry.BoardID = after.BoardID
+		entry.WorkspaceID = after.WorkspaceID
+	}
+
+	if before.WorkspaceID != after.WorkspaceID {
+		entry.Before.WorkspaceID = before.WorkspaceID
+		entry.After.WorkspaceID = after.WorkspaceID
+	}
+	if before.OwnerID != after.OwnerID {
+		entry.Before.OwnerID = before.OwnerID
+		entry.After.OwnerID = after.OwnerID
+	}
+	if before.Name != after.Name {
+		entry.Before.Name = before.Name
+		entry.After.Name = after.Name
+	}
+	if before.Metadata != after.Metadata {
+		entry.Before.Metadata = before.Metadata
+		entry.After.Metadata = after.Metadata
+	}
+	entry.Before.Members = diffMembers(before.Members, after.Members)
+	entry.After.Members = diffMembers(after.Members, before.Members)
+
+	return entry
+}
+
+// Changed reports whether the entry holds any difference worth recording.
+func (e AuditEntry) Changed() bool {
+	return e.Action != ActionUpdate || !reflect.DeepEqual(e.Before, e.After)
+}
+
+func CreateAuditFilter(in *v1.BoardAuditRequest) (AuditFilter, error) {
+	afterID, err := decodePageToken(in.GetPageToken())
+	if err != nil {
+		return AuditFilter{}, err
+	}
+
+	filter := AuditFilter{
+		BoardID: in.GetBoardId(),
+		ActorID: in.GetActorId(),
+		AfterID: afterID,
+	}
+	if in.GetFrom() != nil {
+		filter.From = in.GetFrom().AsTime()
+	}
+	if in.GetTo() != nil {
+		filter.To = in.GetTo().AsTime()
+	}
+	return filter, nil
+}
+
+// diffMembers returns the members of a that are absent from b or have other roles there.
+func diffMembers(a, b []Member) []Member {
+	return slice.Filter(a, func(m Member) bool {
+		other, err := slice.Find(b, func(item Member) bool {
+			return item.MemberID == m.MemberID
+		})
+		return err != nil || !reflect.DeepEqual(other.Roles, m.Roles)
+	})
+}
+
+func encodePageToken(id string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(id))
+}
+
+func decodePageToken(token string) (string, error) {
+	id, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return "", fmt.Errorf("invalid page_token: %w", err)
+	}
+	return string(id), nil
+}
+
+// actorFromContext names who makes a change: the subject of the bearer JWT,
+// which the authentication in front of the service vouches for, or else the
+// actor-id metadata of callers without a token, like boardctl.
+func actorFromContext(ctx context.Context) string {
+	if sub, ok := bearerClaims(fromMetadata(ctx, MetadataAuthorization))["sub"].(string); ok && len(sub) > 0 {
+		return sub
+	}
+	return fromMetadata(ctx, MetadataActorID)
+}
+
+func fromMetadata(ctx context.Context, key string) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
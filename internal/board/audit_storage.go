@@ -0,0 +1,113 @@
+package board
+
+import (
+	"context"
+	"github.com/go-funcards/board-service/internal/tracing"
+	"github.com/go-funcards/slice"
+	"github.com/rs/zerolog"
+)
+
+var _ Storage = (*auditStorage)(nil)
+
+// auditStorage appends an AuditEntry for every change applied through the wrapped Storage.
+type auditStorage struct {
+	Storage
+	audit AuditStorage
+	log   zerolog.Logger
+}
+
+func NewAuditStorage(storage Storage, audit AuditStorage, log zerolog.Logger) *auditStorage {
+	return &auditStorage{
+		Storage: storage,
+		audit:   audit,
+		log:     log.With().Str("storage", "audit").Logger(),
+	}
+}
+
+func (s *auditStorage) Save(ctx context.Context, model Board) error {
+	ctx, changes := WithChanges(ctx)
+	err := s.Storage.Save(ctx, model)
+	s.append(ctx, changes)
+	return err
+}
+
+func (s *auditStorage) SaveMany(ctx context.Context, models []Board) ([]error, error) {
+	ctx, changes := WithChanges(ctx)
+	errs, err := s.Storage.SaveMany(ctx, models)
+	s.append(ctx, changes)
+	return errs, err
+}
+
+func (s *auditStorage) Delete(ctx context.Context, id string) error {
+	ctx, changes := WithChanges(ctx)
+	err := s.Storage.Delete(ctx, id)
+	s.append(ctx, changes)
+	return err
+}
+
+func (s *auditStorage) DeleteMany(ctx context.Context, ids []string) ([]error, error) {
+	ctx, changes := WithChanges(ctx)
+	errs, err := s.Storage.DeleteMany(ctx, ids)
+	s.append(ctx, changes)
+	return errs, err
+}
+
+func (s *auditStorage) Transfer(ctx context.Context, id, ownerID string) error {
+	ctx, changes := WithChanges(ctx)
+	err := s.Storage.Transfer(ctx, id, ownerID)
+	s.append(ctx, changes)
+	return err
+}
+
+func (s *auditStorage) Move(ctx context.Context, id, workspaceID string) error {
+	ctx, changes := WithChanges(ctx)
+	err := s.Storage.Move(ctx, id, workspaceID)
+	s.append(ctx, changes)
+	return err
+}
+
+// append records the changes the wrapped Storage applied, even when the call
+// failed after applying some. A board written several times by the call gets
+// one entry, from the board before the first write to the board after the
+// last. A failure is only logged, like one of the version storage: the
+// change has been applied and must not be reported as failed, which would
+// have the caller retry it.
+func (s *auditStorage) append(ctx context.Context, changes *Changes) {
+	var entries []AuditEntry
+	for _, change := range squash(changes.List()) {
+		if change.Before == nil && change.After == nil {
+			continue
+		}
+		if entry := CreateAuditEntry(ctx, change.Before, change.After); entry.Changed() {
+			entries = append(entries, entry)
+		}
+	}
+
+	if len(entries) == 0 {
+		return
+	}
+
+	if err := s.audit.Append(ctx, entries...); err != nil {
+		ids := slice.Map(entries, func(item AuditEntry) string {
+			return item.BoardID
+		})
+		tracing.Logger(ctx, s.log).Error().Err(err).Strs("board_ids", ids).Msg("audit entries not appended")
+	}
+}
+
+// squash merges the changes of the same board into one, in the order the
+// boards were first changed.
+func squash(changes []Change) []Change {
+	positions := make(map[string]int, len(changes))
+	var data []Change
+	for _, change := range changes {
+		i, ok := positions[change.BoardID()]
+		if !ok {
+			positions[change.BoardID()] = len(data)
+			data = append(data, change)
+			continue
+		}
+		data[i].After = change.After
+	}
+	return data
+}
@@ -0,0 +1,91 @@
+package board
+
+import (
+	"context"
+	"errors"
+	"github.com/rs/zerolog"
+	"testing"
+)
+
+// changingStorage applies saves to a map and records their changes, the way
+// the storages do.
+type changingStorage struct {
+	Storage
+	boards map[string]Board
+}
+
+func (s *changingStorage) SaveMany(ctx context.Context, models []Board) ([]error, error) {
+	for _, model := range models {
+		var current *Board
+		if b, ok := s.boards[model.BoardID]; ok {
+			current = &b
+		}
+		next := Merge(current, model)
+		s.boards[model.BoardID] = next
+		RecordChange(ctx, current, &next)
+	}
+	return make([]error, len(models)), nil
+}
+
+func (s *changingStorage) Find(_ context.Context, filter Filter, _ uint64, _ uint32) ([]Board, error) {
+	var data []Board
+	for _, id := range filter.BoardIDs {
+		if b, ok := s.boards[id]; ok {
+			data = append(data, b)
+		}
+	}
+	return data, nil
+}
+
+type memoryAuditStorage struct {
+	AuditStorage
+	entries []AuditEntry
+	err     error
+}
+
+func (s *memoryAuditStorage) Append(_ context.Context, entries ...AuditEntry) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.entries = append(s.entries, entries...)
+	return nil
+}
+
+func TestAuditStorageSquashesRepeatedBoards(t *testing.T) {
+	base := &changingStorage{boards: map[string]Board{
+		"b1": {BoardID: "b1", OwnerID: "o1", Name: "old", Version: 1},
+	}}
+	audit := new(memoryAuditStorage)
+	s := NewAuditStorage(base, audit, zerolog.Nop())
+
+	errs, err := s.SaveMany(context.Background(), []Board{
+		{BoardID: "b1", Name: "first"},
+		{BoardID: "b2", OwnerID: "o1", Name: "new"},
+		{BoardID: "b1", Name: "second"},
+	})
+	if err != nil || errs[0] != nil || errs[1] != nil || errs[2] != nil {
+		t.Fatalf("got %v (%v), want no error", errs, err)
+	}
+
+	if len(audit.entries) != 2 {
+		t.Fatalf("got %d entries, want one per board", len(audit.entries))
+	}
+	if e := audit.entries[0]; e.BoardID != "b1" || e.Action != ActionUpdate || e.Before.Name != "old" || e.After.Name != "second" {
+		t.Fatalf("got %+v, want b1 updated from old to second", e)
+	}
+	if e := audit.entries[1]; e.BoardID != "b2" || e.Action != ActionCreate || e.After.Name != "new" {
+		t.Fatalf("got %+v, want b2 created", e)
+	}
+}
+
+func TestAuditStorageDoesNotFailAppliedWrites(t *testing.T) {
+	base := &changingStorage{boards: map[string]Board{}}
+	s := NewAuditStorage(base, &memoryAuditStorage{err: errors.New("unavailable")}, zerolog.Nop())
+
+	if _, err := s.SaveMany(context.Background(), []Board{{BoardID: "b1", Name: "new"}}); err != nil {
+		t.Fatalf("got %v, want the applied write reported as such", err)
+	}
+	if _, ok := base.boards["b1"]; !ok {
+		t.Fatal("got no board, want it written")
+	}
+}
@@ -0,0 +1,340 @@
+package board
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/go-funcards/board-service/internal/cache"
+	"github.com/go-funcards/board-service/internal/metrics"
+	"github.com/go-funcards/board-service/internal/tracing"
+	"github.com/go-funcards/slice"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/rs/zerolog"
+	"sort"
+	"time"
+)
+
+const invalidateTimeout = 5 * time.Second
+
+const (
+	cacheKeyBoard  = "board"
+	cacheKeyMember = "member"
+)
+
+var cacheRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: metrics.Namespace,
+	Subsystem: "cache",
+	Name:      "requests_total",
+	Help:      "Total number of board cache lookups, by key kind and result.",
+}, []string{"kind", "result"})
+
+var _ Storage = (*cacheStorage)(nil)
+
+// cacheStorage serves lookups by board ids and by a single member id from
+// a cache, reading through to the wrapped Storage on misses, within any
+// workspaces of the filter. Every other
+// filter goes to the wrapped Storage as it is.
+//
+// Writes invalidate the boards and the member lists they touch, locally and
+// on the other replicas through events. A member list still naming a deleted
+// board is dropped when it is read, so deletes only invalidate the board.
+type cacheStorage struct {
+	Storage
+	cache           cache.Cache
+	events          cache.Events
+	maxMemberBoards uint32
+	log             zerolog.Logger
+}
+
+// NewCacheStorage caches the board lists of members with at most maxMemberBoards boards.
+// events may be nil when the cache is not kept per replica.
+func NewCacheStorage(storage Storage, c cache.Cache, events cache.Events, maxMemberBoards uint32, log zerolog.Logger) *cacheStorage {
+	return &cacheStorage{
+		Storage:         storage,
+		cache:           c,
+		events:          events,
+		maxMemberBoards: maxMemberBoards,
+		log:             log.With().Str("storage", "cache").Logger(),
+	}
+}
+
+// Listen drops the keys invalidated by other replicas until ctx is done.
+func (s *cacheStorage) Listen(ctx context.Context) {
+	if s.events == nil {
+		return
+	}
+
+	s.events.Subscribe(ctx, func(keys []string) {
+		if err := s.cache.Delete(ctx, keys...); err != nil {
+			s.log.Warn().Err(err).Strs("keys", keys).Msg("cache keys not invalidated")
+		}
+	})
+}
+
+func (s *cacheStorage) Save(ctx context.Context, model Board) error {
+	defer s.invalidate(ctx, saveKeys(model)...)
+
+	return s.Storage.Save(ctx, model)
+}
+
+func (s *cacheStorage) SaveMany(ctx context.Context, models []Board) ([]error, error) {
+	var keys []string
+	for _, model := range models {
+		keys = append(keys, saveKeys(model)...)
+	}
+	defer s.invalidate(ctx, keys...)
+
+	return s.Storage.SaveMany(ctx, models)
+}
+
+func (s *cacheStorage) Delete(ctx context.Context, id string) error {
+	defer s.invalidate(ctx, boardKey(id))
+
+	return s.Storage.Delete(ctx, id)
+}
+
+func (s *cacheStorage) DeleteMany(ctx context.Context, ids []string) ([]error, error) {
+	defer s.invalidate(ctx, slice.Map(ids, boardKey)...)
+
+	return s.Storage.DeleteMany(ctx, ids)
+}
+
+func (s *cacheStorage) Transfer(ctx context.Context, id, ownerID string) error {
+	defer s.invalidate(ctx, boardKey(id))
+
+	return s.Storage.Transfer(ctx, id, ownerID)
+}
+
+func (s *cacheStorage) Move(ctx context.Context, id, workspaceID string) error {
+	defer s.invalidate(ctx, boardKey(id))
+
+	return s.Storage.Move(ctx, id, workspaceID)
+}
+
+func (s *cacheStorage) Restore(ctx context.Context, models []Board) ([]error, error) {
+	var keys []string
+	for _, model := range models {
+		keys = append(keys, saveKeys(model)...)
+	}
+	defer s.invalidate(ctx, keys...)
+
+	return s.Storage.Restore(ctx, models)
+}
+
+func (s *cacheStorage) Find(ctx context.Context, filter Filter, index uint64, size uint32) ([]Board, error) {
+	data, ok, err := s.find(ctx, filter)
+	if err != nil || !ok {
+		if err == nil {
+			data, err = s.Storage.Find(ctx, filter, index, size)
+		}
+		return data, err
+	}
+
+	if index >= uint64(len(data)) {
+		return nil, nil
+	}
+	data = data[index:]
+	if size > 0 && uint64(size) < uint64(len(data)) {
+		data = data[:size]
+	}
+	return data, nil
+}
+
+func (s *cacheStorage) Count(ctx context.Context, filter Filter) (uint64, error) {
+	data, ok, err := s.find(ctx, filter)
+	if err != nil || !ok {
+		if err == nil {
+			return s.Storage.Count(ctx, filter)
+		}
+		return 0, err
+	}
+	return uint64(len(data)), nil
+}
+
+// find returns all boards matching filter, newest first, when the filter
+// can be served from the cache.
+func (s *cacheStorage) find(ctx context.Context, filter Filter) ([]Board, bool, error) {
+	var data []Board
+	var err error
+	switch {
+	case len(filter.MemberWorkspaceIDs) > 0:
+		// the boards of whole workspaces are not cached
+		return nil, false, nil
+	case len(filter.BoardIDs) > 0 && len(filter.OwnerIDs) == 0 && len(filter.MemberIDs) == 0:
+		data, err = s.boards(ctx, filter.BoardIDs)
+	case len(filter.BoardIDs) == 0 && len(filter.OwnerIDs) == 0 && len(filter.MemberIDs) == 1:
+		var ok bool
+		if data, ok, err = s.member(ctx, filter.MemberIDs[0]); err != nil || !ok {
+			return nil, false, err
+		}
+	default:
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	if len(filter.WorkspaceIDs) > 0 {
+		// boards are cached once for every workspace
+		data = slice.Filter(data, func(item Board) bool {
+			return slice.Contains(filter.WorkspaceIDs, item.WorkspaceID)
+		})
+	}
+
+	sort.SliceStable(data, func(i, j int) bool {
+		if data[i].CreatedAt.Equal(data[j].CreatedAt) {
+			return data[i].BoardID > data[j].BoardID
+		}
+		return data[i].CreatedAt.After(data[j].CreatedAt)
+	})
+	return data, true, nil
+}
+
+// boards returns the existing boards among ids, loading the missing ones
+// from the wrapped Storage.
+func (s *cacheStorage) boards(ctx context.Context, ids []string) ([]Board, error) {
+	keys := slice.Map(ids, boardKey)
+	cached := s.get(ctx, cacheKeyBoard, keys)
+
+	var data []Board
+	var missing []string
+	seen := make(map[string]bool, len(ids))
+	for i, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		var item Board
+		if value, ok := cached[keys[i]]; ok && json.Unmarshal(value, &item) == nil {
+			data = append(data, item)
+		} else {
+			missing = append(missing, id)
+		}
+	}
+
+	if len(missing) == 0 {
+		return data, nil
+	}
+
+	loaded, err := s.Storage.Find(ctx, Filter{BoardIDs: missing}, 0, uint32(len(missing)))
+	if err != nil {
+		return nil, err
+	}
+
+	s.set(ctx, loaded, nil)
+
+	return append(data, loaded...), nil
+}
+
+// member returns the boards of a member, unless the member has more than
+// maxMemberBoards of them.
+func (s *cacheStorage) member(ctx context.Context, memberID string) ([]Board, bool, error) {
+	key := memberKey(memberID)
+
+	var ids []string
+	if value, ok := s.get(ctx, cacheKeyMember, []string{key})[key]; ok && json.Unmarshal(value, &ids) == nil {
+		data, err := s.boards(ctx, ids)
+		if err != nil {
+			return nil, false, err
+		}
+		if len(data) == len(ids) && len(slice.Filter(data, func(item Board) bool {
+			return !item.hasMember(memberID)
+		})) == 0 {
+			return data, true, nil
+		}
+
+		// a board was deleted or left since the list was cached
+		s.invalidate(ctx, key)
+	}
+
+	data, err := s.Storage.Find(ctx, Filter{MemberIDs: []string{memberID}}, 0, s.maxMemberBoards+1)
+	if err != nil {
+		return nil, false, err
+	}
+	if uint32(len(data)) > s.maxMemberBoards {
+		return nil, false, nil
+	}
+
+	s.set(ctx, data, map[string][]string{
+		key: slice.Map(data, func(item Board) string {
+			return item.BoardID
+		}),
+	})
+
+	return data, true, nil
+}
+
+func (s *cacheStorage) get(ctx context.Context, kind string, keys []string) map[string][]byte {
+	values, err := s.cache.Get(ctx, keys...)
+	if err != nil {
+		// the cache only saves reads, a failing one is a miss
+		tracing.Logger(ctx, s.log).Warn().Err(err).Msg("cache lookup failed")
+	}
+
+	hits := len(values)
+	cacheRequests.WithLabelValues(kind, "hit").Add(float64(hits))
+	cacheRequests.WithLabelValues(kind, "miss").Add(float64(len(keys) - hits))
+
+	return values
+}
+
+func (s *cacheStorage) set(ctx context.Context, data []Board, lists map[string][]string) {
+	items := make(map[string][]byte, len(data)+len(lists))
+	for _, item := range data {
+		if value, err := json.Marshal(item); err == nil {
+			items[boardKey(item.BoardID)] = value
+		}
+	}
+	for key, ids := range lists {
+		if value, err := json.Marshal(ids); err == nil {
+			items[key] = value
+		}
+	}
+
+	if err := s.cache.Set(ctx, items); err != nil {
+		tracing.Logger(ctx, s.log).Warn().Err(err).Msg("cache not filled")
+	}
+}
+
+// invalidate drops keys here and on the other replicas. It runs whether or
+// not the write succeeded, since a failed write may still have been applied,
+// so it does not share the deadline of the write.
+func (s *cacheStorage) invalidate(ctx context.Context, keys ...string) {
+	log := tracing.Logger(ctx, s.log)
+
+	ctx, cancel := context.WithTimeout(context.Background(), invalidateTimeout)
+	defer cancel()
+
+	if err := s.cache.Delete(ctx, keys...); err != nil {
+		log.Error().Err(err).Strs("keys", keys).Msg("cache keys not invalidated")
+	}
+	if s.events == nil {
+		return
+	}
+	if err := s.events.Publish(ctx, keys...); err != nil {
+		log.Error().Err(err).Strs("keys", keys).Msg("cache invalidation not published")
+	}
+}
+
+func (b Board) hasMember(memberID string) bool {
+	for _, m := range b.Members {
+		if m.MemberID == memberID {
+			return true
+		}
+	}
+	return false
+}
+
+func saveKeys(model Board) []string {
+	return append([]string{boardKey(model.BoardID)}, slice.Map(model.Members, func(m Member) string {
+		return memberKey(m.MemberID)
+	})...)
+}
+
+func boardKey(id string) string {
+	return cacheKeyBoard + ":" + id
+}
+
+func memberKey(id string) string {
+	return cacheKeyMember + ":" + id
+}
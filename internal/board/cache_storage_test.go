@@ -0,0 +1,198 @@
+package board
+
+import (
+	"context"
+	"github.com/go-funcards/board-service/internal/cache"
+	"github.com/rs/zerolog"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+)
+
+// memoryStorage keeps boards in a map and counts the reads reaching it.
+type memoryStorage struct {
+	Storage
+	mu     sync.Mutex
+	boards map[string]Board
+	finds  int
+}
+
+func (s *memoryStorage) Save(_ context.Context, model Board) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var current *Board
+	if b, ok := s.boards[model.BoardID]; ok {
+		current = &b
+	}
+	s.boards[model.BoardID] = Merge(current, model)
+	return nil
+}
+
+func (s *memoryStorage) Delete(_ context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.boards, id)
+	return nil
+}
+
+func (s *memoryStorage) Find(_ context.Context, filter Filter, _ uint64, _ uint32) ([]Board, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.finds++
+	var data []Board
+	for _, b := range s.boards {
+		if len(filter.BoardIDs) > 0 && !containsID(filter.BoardIDs, b.BoardID) {
+			continue
+		}
+		if len(filter.MemberIDs) > 0 && !b.hasMember(filter.MemberIDs[0]) {
+			continue
+		}
+		data = append(data, b)
+	}
+	return data, nil
+}
+
+func (s *memoryStorage) reads() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.finds
+}
+
+// bus delivers the keys published by one replica to every subscriber.
+type bus struct {
+	mu   sync.Mutex
+	subs []func(keys []string)
+}
+
+func (b *bus) Publish(_ context.Context, keys ...string) error {
+	b.mu.Lock()
+	subs := make([]func(keys []string), len(b.subs))
+	copy(subs, b.subs)
+	b.mu.Unlock()
+	for _, fn := range subs {
+		fn(keys)
+	}
+	return nil
+}
+
+func (b *bus) Subscribe(_ context.Context, fn func(keys []string)) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.subs = append(b.subs, fn)
+}
+
+func containsID(ids []string, id string) bool {
+	for _, item := range ids {
+		if item == id {
+			return true
+		}
+	}
+	return false
+}
+
+func boardIDs(data []Board) []string {
+	out := make([]string, 0, len(data))
+	for _, b := range data {
+		out = append(out, b.BoardID)
+	}
+	sort.Strings(out)
+	return out
+}
+
+func newCacheStorage(base Storage, events cache.Events) *cacheStorage {
+	return NewCacheStorage(base, cache.NewMemory(100, time.Minute), events, 10, zerolog.Nop())
+}
+
+func TestCacheStorageReadsThroughAndInvalidatesOnWrites(t *testing.T) {
+	ctx := context.Background()
+	base := &memoryStorage{boards: map[string]Board{
+		"b1": {BoardID: "b1", Name: "first", Version: 1, Members: []Member{{MemberID: "m1"}}},
+		"b2": {BoardID: "b2", Name: "second", Version: 1},
+	}}
+	s := newCacheStorage(base, nil)
+
+	lookup := func(filter Filter) []Board {
+		t.Helper()
+		data, err := s.Find(ctx, filter, 0, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return data
+	}
+	expectReads := func(want int) {
+		t.Helper()
+		if got := base.reads(); got != want {
+			t.Fatalf("got %d reads of the storage, want %d", got, want)
+		}
+	}
+
+	// a miss fills the cache, the next lookup is a hit
+	lookup(Filter{BoardIDs: []string{"b1", "b2"}})
+	expectReads(1)
+	if got := lookup(Filter{BoardIDs: []string{"b2", "b1"}}); !equalIDs(boardIDs(got), []string{"b1", "b2"}) {
+		t.Fatalf("got %v, want [b1 b2]", boardIDs(got))
+	}
+	expectReads(1)
+
+	// a saved board is read again
+	if err := s.Save(ctx, Board{BoardID: "b1", Name: "renamed"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := lookup(Filter{BoardIDs: []string{"b1"}}); len(got) != 1 || got[0].Name != "renamed" {
+		t.Fatalf("got %+v, want b1 renamed", got)
+	}
+	expectReads(2)
+
+	// the boards of a member are listed once, then served from the cache
+	lookup(Filter{MemberIDs: []string{"m1"}})
+	expectReads(3)
+	lookup(Filter{MemberIDs: []string{"m1"}})
+	expectReads(3)
+
+	// a member added to a board invalidates the list of the member
+	if err := s.Save(ctx, Board{BoardID: "b2", Members: []Member{{MemberID: "m1"}}}); err != nil {
+		t.Fatal(err)
+	}
+	if got := lookup(Filter{MemberIDs: []string{"m1"}}); !equalIDs(boardIDs(got), []string{"b1", "b2"}) {
+		t.Fatalf("got %v, want [b1 b2]", boardIDs(got))
+	}
+
+	// a deleted board is neither found by id nor listed for its members
+	if err := s.Delete(ctx, "b1"); err != nil {
+		t.Fatal(err)
+	}
+	if got := lookup(Filter{BoardIDs: []string{"b1"}}); len(got) != 0 {
+		t.Fatalf("got %v, want b1 deleted", boardIDs(got))
+	}
+	if got := lookup(Filter{MemberIDs: []string{"m1"}}); !equalIDs(boardIDs(got), []string{"b2"}) {
+		t.Fatalf("got %v, want [b2]", boardIDs(got))
+	}
+}
+
+func TestCacheStorageDropsWhatOtherReplicasChange(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	base := &memoryStorage{boards: map[string]Board{
+		"b1": {BoardID: "b1", Name: "first", Version: 1},
+	}}
+	events := new(bus)
+	reader, writer := newCacheStorage(base, events), newCacheStorage(base, events)
+	reader.Listen(ctx)
+
+	if _, err := reader.Find(ctx, Filter{BoardIDs: []string{"b1"}}, 0, 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := writer.Save(ctx, Board{BoardID: "b1", Name: "renamed"}); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := reader.Find(ctx, Filter{BoardIDs: []string{"b1"}}, 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0].Name != "renamed" {
+		t.Fatalf("got %+v, want the board saved by the other replica", got)
+	}
+}
@@ -0,0 +1,61 @@
+package board
+
+import (
+	"context"
+	"sync"
+)
+
+// Change is what a write of a Storage did to a board: the board as it was
+// before and after the write, nil when it did not exist.
+type Change struct {
+	Before *Board
+	After  *Board
+}
+
+// BoardID returns the id of the board changed.
+func (c Change) BoardID() string {
+	if c.After != nil {
+		return c.After.BoardID
+	}
+	if c.Before != nil {
+		return c.Before.BoardID
+	}
+	return ""
+}
+
+// Changes collects the changes written with a context returned by
+// WithChanges. The storages record them from their writes themselves, as
+// part of the same atomic operation, so that the decorators logging them
+// need not read the boards again around the write, racing other writes.
+type Changes struct {
+	mu     sync.Mutex
+	list   []Change
+	parent *Changes
+}
+
+type changesKey struct{}
+
+// WithChanges returns a context whose writes are recorded in the returned
+// Changes, and in those of the contexts it derives from.
+func WithChanges(ctx context.Context) (context.Context, *Changes) {
+	c := &Changes{}
+	c.parent, _ = ctx.Value(changesKey{}).(*Changes)
+	return context.WithValue(ctx, changesKey{}, c), c
+}
+
+// RecordChange records a write applied with ctx. Storages call it once the
+// write is applied, committed with the others of its transaction if any.
+func RecordChange(ctx context.Context, before, after *Board) {
+	for c, _ := ctx.Value(changesKey{}).(*Changes); c != nil; c = c.parent {
+		c.mu.Lock()
+		c.list = append(c.list, Change{Before: before, After: after})
+		c.mu.Unlock()
+	}
+}
+
+// List returns the changes recorded so far, in the order they were applied.
+func (c *Changes) List() []Change {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]Change(nil), c.list...)
+}
@@ -0,0 +1,109 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-funcards/board-service/internal/board"
+	"github.com/go-funcards/mongodb"
+	"github.com/go-funcards/slice"
+	"github.com/rs/zerolog"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var _ board.AuditStorage = (*auditStorage)(nil)
+
+const auditCollection = "board_audit"
+
+type auditStorage struct {
+	c    *mongo.Collection
+	opts Options
+	log  zerolog.Logger
+}
+
+// NewAuditStorage bounds its operations by the "audit" timeout of opts.
+func NewAuditStorage(db *mongo.Database, opts Options, log zerolog.Logger) *auditStorage {
+	return &auditStorage{
+		c:    db.Collection(auditCollection),
+		opts: opts,
+		log:  log.With().Str("storage", "mongodb").Str("collection", auditCollection).Logger(),
+	}
+}
+
+func (s *auditStorage) Append(ctx context.Context, entries ...board.AuditEntry) error {
+	ctx, cancel := context.WithTimeout(ctx, s.opts.OpTimeout("audit"))
+	defer cancel()
+
+	docs := slice.Map(entries, func(item board.AuditEntry) any {
+		// ObjectID hex strings sort in insertion order, which pagination relies on.
+		item.AuditID = primitive.NewObjectID().Hex()
+		return item
+	})
+
+	if _, err := s.c.InsertMany(ctx, docs); err != nil {
+		return fmt.Errorf(mongodb.ErrMsgQuery, err)
+	}
+
+	s.log.Debug().Int("count", len(docs)).Msg("audit entries appended")
+
+	return nil
+}
+
+func (s *auditStorage) Find(ctx context.Context, filter board.AuditFilter, size uint32) ([]board.AuditEntry, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.opts.OpTimeout("audit"))
+	defer cancel()
+
+	opts := options.Find().SetLimit(int64(size)).SetSort(bson.D{{"_id", -1}})
+	cur, err := s.c.Find(ctx, s.build(filter), opts)
+	if err != nil {
+		return nil, fmt.Errorf(mongodb.ErrMsgQuery, err)
+	}
+	return mongodb.DecodeAll[board.AuditEntry](ctx, cur)
+}
+
+func (s *auditStorage) build(filter board.AuditFilter) any {
+	f := make(mongodb.Filter, 0)
+	if len(filter.BoardID) > 0 {
+		f = append(f, mongodb.Eq("board_id", filter.BoardID))
+	}
+	if len(filter.WorkspaceIDs) > 0 {
+		f = append(f, in("workspace_id", filter.WorkspaceIDs))
+	}
+	if len(filter.ActorID) > 0 {
+		f = append(f, mongodb.Eq("actor_id", filter.ActorID))
+	}
+	if len(filter.AfterID) > 0 {
+		f = append(f, mongodb.Lt("_id", filter.AfterID))
+	}
+	if !filter.From.IsZero() && !filter.To.IsZero() {
+		f = append(f, mongodb.And(
+			mongodb.Gte("created_at", filter.From),
+			mongodb.Lt("created_at", filter.To),
+		))
+	} else if !filter.From.IsZero() {
+		f = append(f, mongodb.Gte("created_at", filter.From))
+	} else if !filter.To.IsZero() {
+		f = append(f, mongodb.Lt("created_at", filter.To))
+	}
+	return f.Build()
+}
+
+func (s *auditStorage) Purge(ctx context.Context, boardIDs ...string) error {
+	if len(boardIDs) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.opts.OpTimeout("audit"))
+	defer cancel()
+
+	res, err := s.c.DeleteMany(ctx, mongodb.Filter{in("board_id", boardIDs)}.Build())
+	if err != nil {
+		return fmt.Errorf(mongodb.ErrMsgQuery, err)
+	}
+
+	s.log.Debug().Int64("count", res.DeletedCount).Msg("audit entries purged")
+
+	return nil
+}
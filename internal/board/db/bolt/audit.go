@@ -0,0 +1,144 @@
+package bolt
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/go-funcards/board-service/internal/board"
+	"github.com/go-funcards/slice"
+	"github.com/rs/zerolog"
+	bolt "go.etcd.io/bbolt"
+)
+
+var _ board.AuditStorage = (*auditStorage)(nil)
+
+type auditStorage struct {
+	db  *bolt.DB
+	log zerolog.Logger
+}
+
+func NewAuditStorage(db *bolt.DB, log zerolog.Logger) *auditStorage {
+	return &auditStorage{
+		db:  db,
+		log: log.With().Str("storage", "bolt").Str("bucket", string(auditBucket)).Logger(),
+	}
+}
+
+func (s *auditStorage) Append(_ context.Context, entries ...board.AuditEntry) error {
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(auditBucket)
+		for _, entry := range entries {
+			seq, err := b.NextSequence()
+			if err != nil {
+				return err
+			}
+			// fixed width hex ids sort in insertion order, which pagination relies on
+			entry.AuditID = fmt.Sprintf("%024x", seq)
+
+			data, err := json.Marshal(entry)
+			if err != nil {
+				return err
+			}
+			if err = b.Put([]byte(entry.AuditID), data); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	s.log.Debug().Int("count", len(entries)).Msg("audit entries appended")
+
+	return nil
+}
+
+// Find scans entries newest first, starting before filter.AfterID when set.
+func (s *auditStorage) Find(_ context.Context, filter board.AuditFilter, size uint32) ([]board.AuditEntry, error) {
+	var data []board.AuditEntry
+	err := s.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(auditBucket).Cursor()
+
+		k, v := c.Last()
+		if len(filter.AfterID) > 0 {
+			after := []byte(filter.AfterID)
+			if k, v = c.Seek(after); k == nil {
+				k, v = c.Last()
+			}
+			for k != nil && bytes.Compare(k, after) >= 0 {
+				k, v = c.Prev()
+			}
+		}
+
+		for ; k != nil && uint32(len(data)) < size; k, v = c.Prev() {
+			var entry board.AuditEntry
+			if err := json.Unmarshal(v, &entry); err != nil {
+				return err
+			}
+			if matchAudit(entry, filter) {
+				data = append(data, entry)
+			}
+		}
+		return nil
+	})
+	return data, err
+}
+
+// Purge scans every entry, audit keys being ordered by insertion only.
+func (s *auditStorage) Purge(_ context.Context, boardIDs ...string) error {
+	if len(boardIDs) == 0 {
+		return nil
+	}
+
+	var stale [][]byte
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(auditBucket)
+		err := b.ForEach(func(k, v []byte) error {
+			var entry board.AuditEntry
+			if err := json.Unmarshal(v, &entry); err != nil {
+				return err
+			}
+			if slice.Contains(boardIDs, entry.BoardID) {
+				stale = append(stale, k)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for _, k := range stale {
+			if err = b.Delete(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	s.log.Debug().Int("count", len(stale)).Msg("audit entries purged")
+
+	return nil
+}
+
+func matchAudit(entry board.AuditEntry, filter board.AuditFilter) bool {
+	if len(filter.BoardID) > 0 && entry.BoardID != filter.BoardID {
+		return false
+	}
+	if len(filter.WorkspaceIDs) > 0 && !slice.Contains(filter.WorkspaceIDs, entry.WorkspaceID) {
+		return false
+	}
+	if len(filter.ActorID) > 0 && entry.ActorID != filter.ActorID {
+		return false
+	}
+	if !filter.From.IsZero() && entry.CreatedAt.Before(filter.From) {
+		return false
+	}
+	if !filter.To.IsZero() && !entry.CreatedAt.Before(filter.To) {
+		return false
+	}
+	return true
+}
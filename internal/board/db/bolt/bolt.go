@@ -0,0 +1,147 @@
+package bolt
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/rs/zerolog"
+	bolt "go.etcd.io/bbolt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const ErrMsgOpen = "failed to open bolt database"
+
+var (
+	boardsBucket           = []byte("boards")
+	createdBucket          = []byte("boards_by_created_at")
+	workspacesBucket       = []byte("boards_by_workspace")
+	ownersBucket           = []byte("boards_by_owner")
+	membersBucket          = []byte("boards_by_member")
+	quotasBucket           = []byte("quotas")
+	auditBucket            = []byte("board_audit")
+	versionsBucket         = []byte("board_versions")
+	workspaceRecordsBucket = []byte("workspaces")
+	separator              = []byte{0}
+	buckets                = [][]byte{boardsBucket, createdBucket, workspacesBucket, ownersBucket, membersBucket, quotasBucket, auditBucket, versionsBucket, workspaceRecordsBucket}
+	openLockTimeout        = 5 * time.Second
+)
+
+// Open opens, creating it when missing, the database file at path. The file
+// is locked, so only one process may use it at a time.
+func Open(path string, log zerolog.Logger) *bolt.DB {
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		log.Fatal().Err(err).Msg(ErrMsgOpen)
+	}
+
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: openLockTimeout})
+	if err != nil {
+		log.Fatal().Err(err).Msg(ErrMsgOpen)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, name := range buckets {
+			if _, err := tx.CreateBucketIfNotExists(name); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Fatal().Err(err).Msg(ErrMsgOpen)
+	}
+
+	log.Info().Str("path", path).Msg("bolt database opened")
+
+	return db
+}
+
+// sortKey orders boards by creation time, then id. The sign bit of the
+// seconds is flipped so that times before 1970 sort first.
+func sortKey(createdAt time.Time, boardID string) []byte {
+	key := make([]byte, 12, 12+len(boardID))
+	binary.BigEndian.PutUint64(key, uint64(createdAt.Unix())^(1<<63))
+	binary.BigEndian.PutUint32(key[8:], uint32(createdAt.Nanosecond()))
+	return append(key, boardID...)
+}
+
+// indexKey prefixes the sort key of a board with the workspace, owner or
+// member id.
+func indexKey(prefix string, createdAt time.Time, boardID string) []byte {
+	return bytes.Join([][]byte{[]byte(prefix), sortKey(createdAt, boardID)}, separator)
+}
+
+// boardIDs returns the ids of the boards indexed under prefix in bucket.
+func boardIDs(b *bolt.Bucket, prefix string) []string {
+	p := append([]byte(prefix), separator...)
+
+	var ids []string
+	c := b.Cursor()
+	for k, _ := c.Seek(p); k != nil && bytes.HasPrefix(k, p); k, _ = c.Next() {
+		ids = append(ids, string(k[len(p)+12:]))
+	}
+	return ids
+}
+
+// cursor walks the sort keys of the boards indexed under prefix in a bucket,
+// newest first. key is the sort key of the current board, nil once done.
+type cursor struct {
+	c      *bolt.Cursor
+	prefix []byte
+	key    []byte
+}
+
+// newCursor starts at the newest board under prefix, the last key before the
+// next prefix; an empty prefix walks the whole bucket.
+func newCursor(b *bolt.Bucket, prefix []byte) *cursor {
+	cur := &cursor{c: b.Cursor(), prefix: prefix}
+
+	var k []byte
+	if len(prefix) == 0 {
+		k, _ = cur.c.Last()
+	} else {
+		upper := append(append([]byte(nil), prefix[:len(prefix)-1]...), prefix[len(prefix)-1]+1)
+		if k, _ = cur.c.Seek(upper); k == nil {
+			k, _ = cur.c.Last()
+		} else {
+			k, _ = cur.c.Prev()
+		}
+	}
+	cur.set(k)
+
+	return cur
+}
+
+// prefixed returns a cursor per id of the index in bucket.
+func prefixed(b *bolt.Bucket, ids []string) []*cursor {
+	cursors := make([]*cursor, 0, len(ids))
+	for _, id := range ids {
+		cursors = append(cursors, newCursor(b, append([]byte(id), separator...)))
+	}
+	return cursors
+}
+
+func (cur *cursor) next() {
+	k, _ := cur.c.Prev()
+	cur.set(k)
+}
+
+func (cur *cursor) set(k []byte) {
+	if k == nil || !bytes.HasPrefix(k, cur.prefix) {
+		cur.key = nil
+		return
+	}
+	cur.key = k[len(cur.prefix):]
+}
+
+// newest returns the cursor at the newest board among cursors, nil when they
+// are all done.
+func newest(cursors []*cursor) *cursor {
+	var found *cursor
+	for _, cur := range cursors {
+		if cur.key != nil && (found == nil || bytes.Compare(cur.key, found.key) > 0) {
+			found = cur
+		}
+	}
+	return found
+}
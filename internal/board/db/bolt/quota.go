@@ -0,0 +1,83 @@
+package bolt
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/go-funcards/board-service/internal/board"
+	"github.com/rs/zerolog"
+	bolt "go.etcd.io/bbolt"
+	"sync/atomic"
+)
+
+var _ board.QuotaStorage = (*quotaStorage)(nil)
+
+// quotaStorage reads limit overrides, stored as JSON by owner id in the
+// quotas bucket.
+type quotaStorage struct {
+	db       *bolt.DB
+	defaults atomic.Value
+	log      zerolog.Logger
+}
+
+func NewQuotaStorage(db *bolt.DB, defaults board.Limits, log zerolog.Logger) *quotaStorage {
+	s := &quotaStorage{
+		db:  db,
+		log: log.With().Str("storage", "bolt").Str("bucket", string(quotasBucket)).Logger(),
+	}
+	s.SetDefaults(defaults)
+	return s
+}
+
+func (s *quotaStorage) SetDefaults(defaults board.Limits) {
+	s.defaults.Store(defaults)
+}
+
+func (s *quotaStorage) Limits(_ context.Context, ownerID string) (board.Limits, error) {
+	var limits board.Limits
+	err := s.db.View(func(tx *bolt.Tx) (err error) {
+		limits, err = s.limits(tx, ownerID)
+		return err
+	})
+	return limits, err
+}
+
+func (s *quotaStorage) limits(tx *bolt.Tx, ownerID string) (board.Limits, error) {
+	defaults := s.defaults.Load().(board.Limits)
+
+	data := tx.Bucket(quotasBucket).Get([]byte(ownerID))
+	if data == nil {
+		return defaults, nil
+	}
+
+	var override board.Limits
+	if err := json.Unmarshal(data, &override); err != nil {
+		return defaults, err
+	}
+	return defaults.Override(override), nil
+}
+
+func (s *quotaStorage) Usage(ctx context.Context, ownerID string) (board.Usage, error) {
+	usage := board.Usage{OwnerID: ownerID}
+	err := s.db.View(func(tx *bolt.Tx) (err error) {
+		if usage.Limits, err = s.limits(tx, ownerID); err != nil {
+			return err
+		}
+		for _, id := range boardIDs(tx.Bucket(ownersBucket), ownerID) {
+			b, err := get(tx, id)
+			if err != nil {
+				return err
+			}
+			if !board.Allowed(ctx, b.WorkspaceID) {
+				continue
+			}
+			members := uint64(len(b.Members))
+			usage.Boards++
+			usage.Members += members
+			if members > usage.LargestBoardMembers {
+				usage.LargestBoardMembers = members
+			}
+		}
+		return nil
+	})
+	return usage, err
+}
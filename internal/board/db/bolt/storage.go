@@ -0,0 +1,583 @@
+package bolt
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/go-funcards/board-service/internal/board"
+	"github.com/go-funcards/board-service/internal/tracing"
+	"github.com/go-funcards/slice"
+	"github.com/rs/zerolog"
+	bolt "go.etcd.io/bbolt"
+	"sort"
+)
+
+var _ board.Storage = (*storage)(nil)
+
+var errNotFound = errors.New("board not found")
+
+type storage struct {
+	db     *bolt.DB
+	quotas *quotaStorage
+	log    zerolog.Logger
+}
+
+func NewStorage(db *bolt.DB, quotas *quotaStorage, log zerolog.Logger) *storage {
+	return &storage{
+		db:     db,
+		quotas: quotas,
+		log:    log.With().Str("storage", "bolt").Str("bucket", string(boardsBucket)).Logger(),
+	}
+}
+
+func (s *storage) Save(ctx context.Context, model board.Board) error {
+	tracing.Logger(ctx, s.log).Info().Str("board_id", model.BoardID).Msg("board save")
+
+	var change board.Change
+	if err := s.db.Update(func(tx *bolt.Tx) (err error) {
+		change, err = s.save(ctx, tx, model)
+		return err
+	}); err != nil {
+		return err
+	}
+	board.RecordChange(ctx, change.Before, change.After)
+
+	tracing.Logger(ctx, s.log).Info().Str("board_id", model.BoardID).Msg("board saved")
+
+	return nil
+}
+
+// SaveMany saves models in order within one transaction. A board failing its
+// checks is not written, the others are, like an unordered bulk write.
+func (s *storage) SaveMany(ctx context.Context, models []board.Board) ([]error, error) {
+	tracing.Logger(ctx, s.log).Info().Int("count", len(models)).Msg("boards save")
+
+	errs := make([]error, len(models))
+	var changes []board.Change
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		for i, model := range models {
+			change, err := s.save(ctx, tx, model)
+			if err == nil {
+				changes = append(changes, change)
+				continue
+			}
+			var e *board.Error
+			if !errors.As(err, &e) {
+				return err
+			}
+			errs[i] = err
+		}
+		return nil
+	})
+	if err != nil {
+		return errs, err
+	}
+
+	for _, change := range changes {
+		board.RecordChange(ctx, change.Before, change.After)
+	}
+
+	return errs, nil
+}
+
+// save merges model into the stored board the way the mongodb storage does:
+// empty fields are left untouched, the workspace, owner and creation time are
+// only set on insert, deleted members are removed before the others are added.
+// With model.Replace the owner, name, metadata and members are replaced. Limits
+// are checked before anything is written; writes are serialized by bolt, so
+// the checks can't race. An existing board outside the tenant of ctx is not
+// found.
+func (s *storage) save(ctx context.Context, tx *bolt.Tx, model board.Board) (board.Change, error) {
+	current, err := get(tx, model.BoardID)
+	inserted := errors.Is(err, errNotFound)
+	if err != nil && !inserted {
+		return board.Change{}, err
+	}
+	if !inserted && !board.Allowed(ctx, current.WorkspaceID) {
+		return board.Change{}, board.NotFound(board.ResourceBoard, model.BoardID, nil)
+	}
+
+	var before *board.Board
+	if !inserted {
+		before = &current
+	}
+	next := board.Merge(before, model)
+	added := slice.Filter(model.Members, func(item board.Member) bool {
+		return !item.Delete
+	})
+
+	if inserted {
+		if err = workspaceExists(tx, next.WorkspaceID); err != nil {
+			return board.Change{}, err
+		}
+	}
+
+	limits, err := s.quotas.limits(tx, next.OwnerID)
+	if err != nil {
+		return board.Change{}, err
+	}
+	transferred := !inserted && next.OwnerID != current.OwnerID
+	if (inserted || transferred) && len(next.OwnerID) > 0 && limits.MaxBoards > 0 &&
+		uint64(len(boardIDs(tx.Bucket(ownersBucket), next.OwnerID))) >= limits.MaxBoards {
+		return board.Change{}, board.BoardQuotaExceeded(next.OwnerID, limits.MaxBoards)
+	}
+	if limits.MaxMembers > 0 && (len(added) > 0 || transferred) && uint64(len(next.Members)) > limits.MaxMembers {
+		return board.Change{}, board.MemberQuotaExceeded(next.BoardID, limits.MaxMembers)
+	}
+
+	if !inserted {
+		if err = unindex(tx, current); err != nil {
+			return board.Change{}, err
+		}
+	}
+	return board.Change{Before: before, After: &next}, put(tx, next)
+}
+
+// Restore puts models as they are within one transaction, skipping the
+// boards that already exist. Limits are not checked, the boards were
+// within them when backed up.
+func (s *storage) Restore(ctx context.Context, models []board.Board) ([]error, error) {
+	tracing.Logger(ctx, s.log).Info().Int("count", len(models)).Msg("boards restore")
+
+	errs := make([]error, len(models))
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		for i, model := range models {
+			if _, err := get(tx, model.BoardID); !errors.Is(err, errNotFound) {
+				if err != nil {
+					return err
+				}
+				errs[i] = board.AlreadyExists(board.ResourceBoard, model.BoardID, nil)
+				continue
+			}
+			if err := put(tx, model); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return errs, err
+}
+
+func (s *storage) Delete(ctx context.Context, id string) error {
+	tracing.Logger(ctx, s.log).Debug().Str("board_id", id).Msg("board delete")
+
+	var deleted board.Board
+	if err := s.db.Update(func(tx *bolt.Tx) (err error) {
+		deleted, err = remove(ctx, tx, id)
+		return err
+	}); err != nil {
+		return err
+	}
+	board.RecordChange(ctx, &deleted, nil)
+
+	tracing.Logger(ctx, s.log).Debug().Str("board_id", id).Msg("board deleted")
+
+	return nil
+}
+
+func (s *storage) DeleteMany(ctx context.Context, ids []string) ([]error, error) {
+	tracing.Logger(ctx, s.log).Debug().Strs("board_ids", ids).Msg("boards delete")
+
+	errs := make([]error, len(ids))
+	var deleted []board.Board
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		for i, id := range ids {
+			b, err := remove(ctx, tx, id)
+			var e *board.Error
+			if errors.As(err, &e) {
+				errs[i] = err
+			} else if err != nil {
+				return err
+			} else {
+				deleted = append(deleted, b)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return errs, err
+	}
+
+	for i := range deleted {
+		board.RecordChange(ctx, &deleted[i], nil)
+	}
+
+	return errs, nil
+}
+
+func (s *storage) Transfer(ctx context.Context, id, ownerID string) error {
+	tracing.Logger(ctx, s.log).Info().Str("board_id", id).Str("owner_id", ownerID).Msg("board transfer")
+
+	var change board.Change
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		current, err := lookup(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if current.OwnerID == ownerID {
+			return nil
+		}
+
+		limits, err := s.quotas.limits(tx, ownerID)
+		if err != nil {
+			return err
+		}
+		if limits.MaxBoards > 0 && uint64(len(boardIDs(tx.Bucket(ownersBucket), ownerID))) >= limits.MaxBoards {
+			return board.BoardQuotaExceeded(ownerID, limits.MaxBoards)
+		}
+		if limits.MaxMembers > 0 && uint64(len(current.Members)) > limits.MaxMembers {
+			return board.MemberQuotaExceeded(id, limits.MaxMembers)
+		}
+
+		if err = unindex(tx, current); err != nil {
+			return err
+		}
+		next := current
+		next.OwnerID = ownerID
+		next.Version++
+		change = board.Change{Before: &current, After: &next}
+		return put(tx, next)
+	})
+	if err == nil && change.After != nil {
+		board.RecordChange(ctx, change.Before, change.After)
+	}
+	return err
+}
+
+func (s *storage) Move(ctx context.Context, id, workspaceID string) error {
+	tracing.Logger(ctx, s.log).Info().Str("board_id", id).Str("workspace_id", workspaceID).Msg("board move")
+
+	var change board.Change
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		current, err := lookup(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if current.WorkspaceID == workspaceID {
+			return nil
+		}
+		if !board.Allowed(ctx, workspaceID) {
+			return board.PermissionDenied(board.ResourceWorkspace, workspaceID, "workspace is not available to the caller")
+		}
+		if err = workspaceExists(tx, workspaceID); err != nil {
+			return err
+		}
+
+		if err = unindex(tx, current); err != nil {
+			return err
+		}
+		next := current
+		next.WorkspaceID = workspaceID
+		next.Version++
+		change = board.Change{Before: &current, After: &next}
+		return put(tx, next)
+	})
+	if err == nil && change.After != nil {
+		board.RecordChange(ctx, change.Before, change.After)
+	}
+	return err
+}
+
+// Find walks the indexes newest first, skipping index boards and stopping
+// once the page is full. Only the boards of the page are decoded, unless the
+// filter cannot be told from the indexes alone.
+func (s *storage) Find(ctx context.Context, filter board.Filter, index uint64, size uint32) ([]board.Board, error) {
+	var data []board.Board
+	err := s.db.View(func(tx *bolt.Tx) error {
+		var skipped uint64
+		return walk(ctx, tx, filter, func(id string, b *board.Board) (bool, error) {
+			if skipped < index {
+				skipped++
+				return true, nil
+			}
+			if b == nil {
+				found, err := get(tx, id)
+				if errors.Is(err, errNotFound) {
+					return true, nil
+				}
+				if err != nil {
+					return false, err
+				}
+				b = &found
+			}
+			data = append(data, project(*b, filter.Fields))
+			return size == 0 || len(data) < int(size), nil
+		})
+	})
+	return data, err
+}
+
+func (s *storage) Count(ctx context.Context, filter board.Filter) (uint64, error) {
+	var total uint64
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return walk(ctx, tx, filter, func(string, *board.Board) (bool, error) {
+			total++
+			return true, nil
+		})
+	})
+	return total, err
+}
+
+// Stats counts the keys of the boards bucket and of the member index, which
+// holds a key per member of every board.
+func (s *storage) Stats(_ context.Context) (board.Stats, error) {
+	var stats board.Stats
+	err := s.db.View(func(tx *bolt.Tx) error {
+		stats.Boards = uint64(tx.Bucket(boardsBucket).Stats().KeyN)
+		stats.Members = uint64(tx.Bucket(membersBucket).Stats().KeyN)
+		return nil
+	})
+	return stats, err
+}
+
+// walk calls fn with the boards matching filter, newest first, until fn
+// returns false or ctx is done. Boards looked up by id are sorted in memory,
+// the others are walked in the owner, member and workspace indexes, or in
+// the creation time index, merged newest first. The boards are decoded and
+// passed to fn only when the indexes walked do not match the filter alone,
+// when workspaces narrow owners or members; fn gets nil otherwise.
+func walk(ctx context.Context, tx *bolt.Tx, filter board.Filter, fn func(id string, b *board.Board) (bool, error)) error {
+	if len(filter.BoardIDs) > 0 {
+		return walkIDs(ctx, tx, filter, fn)
+	}
+
+	or := len(filter.OwnerIDs) > 0 || len(filter.MemberIDs) > 0 || len(filter.MemberWorkspaceIDs) > 0
+	var cursors []*cursor
+	switch {
+	case or:
+		cursors = append(cursors, prefixed(tx.Bucket(ownersBucket), filter.OwnerIDs)...)
+		cursors = append(cursors, prefixed(tx.Bucket(membersBucket), filter.MemberIDs)...)
+		cursors = append(cursors, prefixed(tx.Bucket(workspacesBucket), filter.MemberWorkspaceIDs)...)
+	case len(filter.WorkspaceIDs) > 0:
+		cursors = prefixed(tx.Bucket(workspacesBucket), filter.WorkspaceIDs)
+	default:
+		cursors = []*cursor{newCursor(tx.Bucket(createdBucket), nil)}
+	}
+	exact := !or || len(filter.WorkspaceIDs) == 0
+
+	var last []byte
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		cur := newest(cursors)
+		if cur == nil {
+			return nil
+		}
+		key := cur.key
+		cur.next()
+
+		// a board in several of the indexes walked comes up once per index
+		if bytes.Equal(key, last) {
+			continue
+		}
+		last = key
+		id := string(key[12:])
+
+		var b *board.Board
+		if !exact {
+			found, err := get(tx, id)
+			if errors.Is(err, errNotFound) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			if !match(found, filter) {
+				continue
+			}
+			b = &found
+		}
+
+		if more, err := fn(id, b); err != nil || !more {
+			return err
+		}
+	}
+}
+
+// walkIDs passes the boards of filter.BoardIDs which match the rest of it to
+// fn, newest first.
+func walkIDs(ctx context.Context, tx *bolt.Tx, filter board.Filter, fn func(id string, b *board.Board) (bool, error)) error {
+	seen := make(map[string]bool, len(filter.BoardIDs))
+	var data []board.Board
+	for _, id := range filter.BoardIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		b, err := get(tx, id)
+		if errors.Is(err, errNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if match(b, filter) {
+			data = append(data, b)
+		}
+	}
+
+	sort.SliceStable(data, func(i, j int) bool {
+		return data[i].CreatedAt.After(data[j].CreatedAt)
+	})
+
+	for i := range data {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if more, err := fn(data[i].BoardID, &data[i]); err != nil || !more {
+			return err
+		}
+	}
+	return nil
+}
+
+// project keeps the fields of b named in fields, by their JSON names, like
+// the projection of the mongodb storage does; all of them without fields.
+func project(b board.Board, fields []string) board.Board {
+	if len(fields) == 0 {
+		return b
+	}
+
+	var p board.Board
+	for _, field := range fields {
+		switch field {
+		case "board_id":
+			p.BoardID = b.BoardID
+		case "workspace_id":
+			p.WorkspaceID = b.WorkspaceID
+		case "owner_id":
+			p.OwnerID = b.OwnerID
+		case "name":
+			p.Name = b.Name
+		case "metadata":
+			p.Metadata = b.Metadata
+		case "created_at":
+			p.CreatedAt = b.CreatedAt
+		case "members":
+			p.Members = b.Members
+		case "version":
+			p.Version = b.Version
+		}
+	}
+	return p
+}
+
+// match applies filter like the mongodb storage: board ids, workspace ids,
+// and owner OR member ids OR member workspace ids when several are given.
+func match(b board.Board, filter board.Filter) bool {
+	if len(filter.BoardIDs) > 0 && !slice.Contains(filter.BoardIDs, b.BoardID) {
+		return false
+	}
+	if len(filter.WorkspaceIDs) > 0 && !slice.Contains(filter.WorkspaceIDs, b.WorkspaceID) {
+		return false
+	}
+	if len(filter.OwnerIDs) == 0 && len(filter.MemberIDs) == 0 && len(filter.MemberWorkspaceIDs) == 0 {
+		return true
+	}
+	if slice.Contains(filter.OwnerIDs, b.OwnerID) || slice.Contains(filter.MemberWorkspaceIDs, b.WorkspaceID) {
+		return true
+	}
+	for _, m := range b.Members {
+		if slice.Contains(filter.MemberIDs, m.MemberID) {
+			return true
+		}
+	}
+	return false
+}
+
+func get(tx *bolt.Tx, id string) (board.Board, error) {
+	var b board.Board
+	data := tx.Bucket(boardsBucket).Get([]byte(id))
+	if data == nil {
+		return b, errNotFound
+	}
+	err := json.Unmarshal(data, &b)
+	return b, err
+}
+
+func put(tx *bolt.Tx, b board.Board) error {
+	data, err := json.Marshal(b)
+	if err != nil {
+		return err
+	}
+	if err = tx.Bucket(boardsBucket).Put([]byte(b.BoardID), data); err != nil {
+		return err
+	}
+	if err = tx.Bucket(createdBucket).Put(sortKey(b.CreatedAt, b.BoardID), nil); err != nil {
+		return err
+	}
+	if len(b.WorkspaceID) > 0 {
+		if err = tx.Bucket(workspacesBucket).Put(indexKey(b.WorkspaceID, b.CreatedAt, b.BoardID), nil); err != nil {
+			return err
+		}
+	}
+	if err = tx.Bucket(ownersBucket).Put(indexKey(b.OwnerID, b.CreatedAt, b.BoardID), nil); err != nil {
+		return err
+	}
+	for _, m := range b.Members {
+		if err = tx.Bucket(membersBucket).Put(indexKey(m.MemberID, b.CreatedAt, b.BoardID), nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func unindex(tx *bolt.Tx, b board.Board) error {
+	if err := tx.Bucket(createdBucket).Delete(sortKey(b.CreatedAt, b.BoardID)); err != nil {
+		return err
+	}
+	if err := tx.Bucket(workspacesBucket).Delete(indexKey(b.WorkspaceID, b.CreatedAt, b.BoardID)); err != nil {
+		return err
+	}
+	if err := tx.Bucket(ownersBucket).Delete(indexKey(b.OwnerID, b.CreatedAt, b.BoardID)); err != nil {
+		return err
+	}
+	for _, m := range b.Members {
+		if err := tx.Bucket(membersBucket).Delete(indexKey(m.MemberID, b.CreatedAt, b.BoardID)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// workspaceExists fails with NotFound when there is no workspace with the
+// id, unless it is empty. Checked in the transaction writing the board, the
+// workspace can't be deleted before the board is written.
+func workspaceExists(tx *bolt.Tx, id string) error {
+	if len(id) > 0 && tx.Bucket(workspaceRecordsBucket).Get([]byte(id)) == nil {
+		return board.NotFound(board.ResourceWorkspace, id, nil)
+	}
+	return nil
+}
+
+// lookup returns the board to be written with ctx, which is not found
+// outside of the tenant of ctx.
+func lookup(ctx context.Context, tx *bolt.Tx, id string) (board.Board, error) {
+	b, err := get(tx, id)
+	if errors.Is(err, errNotFound) {
+		return b, board.NotFound(board.ResourceBoard, id, err)
+	}
+	if err != nil {
+		return b, err
+	}
+	if !board.Allowed(ctx, b.WorkspaceID) {
+		return b, board.NotFound(board.ResourceBoard, id, nil)
+	}
+	return b, nil
+}
+
+// remove deletes the board and returns it as it was.
+func remove(ctx context.Context, tx *bolt.Tx, id string) (board.Board, error) {
+	b, err := lookup(ctx, tx, id)
+	if err != nil {
+		return b, err
+	}
+	if err = unindex(tx, b); err != nil {
+		return b, err
+	}
+	return b, tx.Bucket(boardsBucket).Delete([]byte(id))
+}
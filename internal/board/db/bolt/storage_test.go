@@ -0,0 +1,38 @@
+package bolt
+
+import (
+	"github.com/go-funcards/board-service/internal/board"
+	"github.com/go-funcards/board-service/internal/board/storagetest"
+	"github.com/rs/zerolog"
+	"path/filepath"
+	"testing"
+)
+
+func TestStorage(t *testing.T) {
+	storagetest.Run(t, func(t *testing.T) storagetest.Storages {
+		return open(t, board.Limits{})
+	})
+}
+
+func TestStorageLimits(t *testing.T) {
+	storagetest.RunLimits(t, open)
+}
+
+func TestWorkspaceStorage(t *testing.T) {
+	storagetest.RunWorkspaces(t, func(t *testing.T) storagetest.Storages {
+		return open(t, board.Limits{})
+	})
+}
+
+func open(t *testing.T, limits board.Limits) storagetest.Storages {
+	log := zerolog.Nop()
+	db := Open(filepath.Join(t.TempDir(), "boards.db"), log)
+	t.Cleanup(func() { _ = db.Close() })
+
+	quotas := NewQuotaStorage(db, limits, log)
+	return storagetest.Storages{
+		Boards:     NewStorage(db, quotas, log),
+		Workspaces: NewWorkspaceStorage(db, log),
+		Quotas:     quotas,
+	}
+}
@@ -0,0 +1,202 @@
+package bolt
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/go-funcards/board-service/internal/board"
+	"github.com/rs/zerolog"
+	bolt "go.etcd.io/bbolt"
+	"time"
+)
+
+var _ board.VersionStorage = (*versionStorage)(nil)
+
+var errVersionNotFound = errors.New("board version not found")
+
+type versionStorage struct {
+	db       *bolt.DB
+	maxCount uint64
+	maxAge   time.Duration
+	log      zerolog.Logger
+}
+
+// NewVersionStorage keeps at most maxCount versions per board (0 keeps all)
+// and drops versions older than maxAge (0 never drops them) when a board gets
+// a new one.
+func NewVersionStorage(db *bolt.DB, maxCount uint64, maxAge time.Duration, log zerolog.Logger) *versionStorage {
+	return &versionStorage{
+		db:       db,
+		maxCount: maxCount,
+		maxAge:   maxAge,
+		log:      log.With().Str("storage", "bolt").Str("bucket", string(versionsBucket)).Logger(),
+	}
+}
+
+func versionKey(boardID string, version uint64) []byte {
+	key := make([]byte, len(boardID)+9)
+	copy(key, boardID)
+	key[len(boardID)] = separator[0]
+	binary.BigEndian.PutUint64(key[len(boardID)+1:], version)
+	return key
+}
+
+func versionPrefix(boardID string) []byte {
+	return append([]byte(boardID), separator...)
+}
+
+func (s *versionStorage) Append(_ context.Context, versions ...board.BoardVersion) error {
+	if len(versions) == 0 {
+		return nil
+	}
+
+	// a recorded version is never replaced, the others are still stored
+	var conflict error
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(versionsBucket)
+		for _, v := range versions {
+			key := versionKey(v.BoardID, v.Version)
+			if b.Get(key) != nil {
+				if conflict == nil {
+					conflict = board.AlreadyExists(board.ResourceBoardVersion, fmt.Sprintf("%s@%d", v.BoardID, v.Version), nil)
+				}
+				continue
+			}
+			data, err := json.Marshal(v)
+			if err != nil {
+				return err
+			}
+			if err = b.Put(key, data); err != nil {
+				return err
+			}
+			if err = s.prune(b, v); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	s.log.Debug().Int("count", len(versions)).Msg("board versions appended")
+
+	return conflict
+}
+
+// prune deletes the versions of the board of v beyond the retention limits.
+func (s *versionStorage) prune(b *bolt.Bucket, v board.BoardVersion) error {
+	prefix := versionPrefix(v.BoardID)
+	expired := time.Now().Add(-s.maxAge)
+
+	var stale [][]byte
+	c := b.Cursor()
+	for k, data := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, data = c.Next() {
+		version := binary.BigEndian.Uint64(k[len(prefix):])
+		if s.maxCount > 0 && version+s.maxCount <= v.Version {
+			stale = append(stale, k)
+			continue
+		}
+		if s.maxAge > 0 {
+			var old board.BoardVersion
+			if err := json.Unmarshal(data, &old); err != nil {
+				return err
+			}
+			if old.CreatedAt.Before(expired) {
+				stale = append(stale, k)
+			}
+		}
+	}
+
+	for _, k := range stale {
+		if err := b.Delete(k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *versionStorage) FindOne(_ context.Context, boardID string, version uint64) (board.BoardVersion, error) {
+	var v board.BoardVersion
+	err := s.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket(versionsBucket).Get(versionKey(boardID, version))
+		if data == nil {
+			return board.NotFound(board.ResourceBoardVersion, fmt.Sprintf("%s@%d", boardID, version), errVersionNotFound)
+		}
+		return json.Unmarshal(data, &v)
+	})
+	return v, err
+}
+
+// Find pages through the versions of the board, newest first.
+func (s *versionStorage) Find(_ context.Context, boardID string, index uint64, size uint32) ([]board.BoardVersion, error) {
+	var data []board.BoardVersion
+	err := s.db.View(func(tx *bolt.Tx) error {
+		prefix := versionPrefix(boardID)
+		c := tx.Bucket(versionsBucket).Cursor()
+
+		k, v := c.Seek(versionKey(boardID, ^uint64(0)))
+		if k == nil || !bytes.HasPrefix(k, prefix) {
+			k, v = c.Prev()
+		}
+		for skipped := uint64(0); k != nil && bytes.HasPrefix(k, prefix) && uint32(len(data)) < size; k, v = c.Prev() {
+			if skipped < index {
+				skipped++
+				continue
+			}
+			var item board.BoardVersion
+			if err := json.Unmarshal(v, &item); err != nil {
+				return err
+			}
+			data = append(data, item)
+		}
+		return nil
+	})
+	return data, err
+}
+
+func (s *versionStorage) Count(_ context.Context, boardID string) (uint64, error) {
+	var total uint64
+	err := s.db.View(func(tx *bolt.Tx) error {
+		prefix := versionPrefix(boardID)
+		c := tx.Bucket(versionsBucket).Cursor()
+		for k, _ := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, _ = c.Next() {
+			total++
+		}
+		return nil
+	})
+	return total, err
+}
+
+func (s *versionStorage) Purge(_ context.Context, boardIDs ...string) error {
+	var count int
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(versionsBucket)
+		for _, boardID := range boardIDs {
+			prefix := versionPrefix(boardID)
+
+			var stale [][]byte
+			c := b.Cursor()
+			for k, _ := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, _ = c.Next() {
+				stale = append(stale, k)
+			}
+			for _, k := range stale {
+				if err := b.Delete(k); err != nil {
+					return err
+				}
+			}
+			count += len(stale)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	s.log.Debug().Int("count", count).Msg("board versions purged")
+
+	return nil
+}
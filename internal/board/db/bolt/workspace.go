@@ -0,0 +1,180 @@
+package bolt
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/go-funcards/board-service/internal/board"
+	"github.com/go-funcards/board-service/internal/tracing"
+	"github.com/go-funcards/slice"
+	"github.com/rs/zerolog"
+	bolt "go.etcd.io/bbolt"
+	"sort"
+)
+
+var _ board.WorkspaceStorage = (*workspaceStorage)(nil)
+
+// workspaceStorage keeps workspaces as JSON by id in the workspaces bucket.
+// There are few of them next to the boards, so they are looked up by
+// scanning the bucket rather than through indexes.
+type workspaceStorage struct {
+	db  *bolt.DB
+	log zerolog.Logger
+}
+
+func NewWorkspaceStorage(db *bolt.DB, log zerolog.Logger) *workspaceStorage {
+	return &workspaceStorage{
+		db:  db,
+		log: log.With().Str("storage", "bolt").Str("bucket", string(workspaceRecordsBucket)).Logger(),
+	}
+}
+
+func (s *workspaceStorage) Create(ctx context.Context, model board.Workspace) error {
+	tracing.Logger(ctx, s.log).Info().Str("workspace_id", model.WorkspaceID).Msg("workspace create")
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(workspaceRecordsBucket)
+		if b.Get([]byte(model.WorkspaceID)) != nil {
+			return board.AlreadyExists(board.ResourceWorkspace, model.WorkspaceID, nil)
+		}
+
+		next := board.Workspace{
+			WorkspaceID: model.WorkspaceID,
+			OwnerID:     model.OwnerID,
+			Name:        model.Name,
+			CreatedAt:   model.CreatedAt,
+		}
+		next.Members = merge(nil, model.Members)
+		return putWorkspace(b, next)
+	})
+}
+
+// Update merges model into the stored workspace like boards are merged: the
+// members being changed are replaced.
+func (s *workspaceStorage) Update(ctx context.Context, model board.Workspace) error {
+	tracing.Logger(ctx, s.log).Info().Str("workspace_id", model.WorkspaceID).Msg("workspace update")
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(workspaceRecordsBucket)
+
+		data := b.Get([]byte(model.WorkspaceID))
+		if data == nil {
+			return board.NotFound(board.ResourceWorkspace, model.WorkspaceID, nil)
+		}
+		var next board.Workspace
+		if err := json.Unmarshal(data, &next); err != nil {
+			return err
+		}
+		if len(model.Name) > 0 {
+			next.Name = model.Name
+		}
+		next.Members = merge(next.Members, model.Members)
+		return putWorkspace(b, next)
+	})
+}
+
+// Delete counts the boards of the workspace in the transaction deleting it,
+// which board writes are serialized with.
+func (s *workspaceStorage) Delete(ctx context.Context, id string) error {
+	tracing.Logger(ctx, s.log).Debug().Str("workspace_id", id).Msg("workspace delete")
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(workspaceRecordsBucket)
+		if b.Get([]byte(id)) == nil {
+			return board.NotFound(board.ResourceWorkspace, id, nil)
+		}
+		if n := len(boardIDs(tx.Bucket(workspacesBucket), id)); n > 0 {
+			return board.Conflict(board.ResourceWorkspace, id, fmt.Sprintf("workspace still has %d boards", n))
+		}
+		return b.Delete([]byte(id))
+	})
+}
+
+func putWorkspace(b *bolt.Bucket, w board.Workspace) error {
+	data, err := json.Marshal(w)
+	if err != nil {
+		return err
+	}
+	return b.Put([]byte(w.WorkspaceID), data)
+}
+
+// merge replaces the members of current being changed, dropping the deleted
+// ones.
+func merge(current, changes []board.Member) []board.Member {
+	changed := slice.Map(changes, func(item board.Member) string {
+		return item.MemberID
+	})
+	members := slice.Filter(current, func(item board.Member) bool {
+		return !slice.Contains(changed, item.MemberID)
+	})
+	added := make(map[string]bool)
+	for _, m := range changes {
+		if !m.Delete && !added[m.MemberID] {
+			added[m.MemberID] = true
+			members = append(members, board.Member{MemberID: m.MemberID, Roles: m.Roles})
+		}
+	}
+	return members
+}
+
+func (s *workspaceStorage) Find(_ context.Context, filter board.WorkspaceFilter, index uint64, size uint32) ([]board.Workspace, error) {
+	data, err := s.find(filter)
+	if err != nil || index >= uint64(len(data)) {
+		return nil, err
+	}
+	data = data[index:]
+	if size > 0 && uint64(size) < uint64(len(data)) {
+		data = data[:size]
+	}
+	return data, nil
+}
+
+func (s *workspaceStorage) Count(_ context.Context, filter board.WorkspaceFilter) (uint64, error) {
+	data, err := s.find(filter)
+	return uint64(len(data)), err
+}
+
+// find returns the workspaces matching filter, newest first.
+func (s *workspaceStorage) find(filter board.WorkspaceFilter) ([]board.Workspace, error) {
+	var data []board.Workspace
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(workspaceRecordsBucket).ForEach(func(_, value []byte) error {
+			var w board.Workspace
+			if err := json.Unmarshal(value, &w); err != nil {
+				return err
+			}
+			if matchWorkspace(w, filter) {
+				data = append(data, w)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(data, func(i, j int) bool {
+		return data[i].CreatedAt.After(data[j].CreatedAt)
+	})
+	return data, nil
+}
+
+// matchWorkspace applies filter like the mongodb storage: workspace ids, and
+// owner OR member ids when both are given.
+func matchWorkspace(w board.Workspace, filter board.WorkspaceFilter) bool {
+	if len(filter.WorkspaceIDs) > 0 && !slice.Contains(filter.WorkspaceIDs, w.WorkspaceID) {
+		return false
+	}
+	if len(filter.OwnerIDs) == 0 && len(filter.MemberIDs) == 0 {
+		return true
+	}
+	if slice.Contains(filter.OwnerIDs, w.OwnerID) {
+		return true
+	}
+	for _, m := range w.Members {
+		if slice.Contains(filter.MemberIDs, m.MemberID) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,307 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	idempotencydb "github.com/go-funcards/board-service/internal/idempotency/db"
+	"github.com/go-funcards/mongodb"
+	"github.com/rs/zerolog"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	IndexKeep       = "keep"
+	IndexCreate     = "create"
+	IndexRebuild    = "rebuild"
+	IndexDrop       = "drop"
+	IndexUnexpected = "unexpected"
+)
+
+// Server error codes of listing the indexes of a missing collection and of
+// dropping a missing index.
+const (
+	namespaceNotFound = 26
+	indexNotFound     = 27
+)
+
+// Index declares an index by its keys, which also give its name the way
+// MongoDB names indexes by default.
+type Index struct {
+	Keys        bson.D
+	Unique      bool
+	ExpireAfter time.Duration
+}
+
+func (i Index) Name() string {
+	parts := make([]string, 0, len(i.Keys))
+	for _, key := range i.Keys {
+		parts = append(parts, fmt.Sprintf("%s_%v", key.Key, key.Value))
+	}
+	return strings.Join(parts, "_")
+}
+
+func (i Index) String() string {
+	parts := make([]string, 0, len(i.Keys)+2)
+	for _, key := range i.Keys {
+		parts = append(parts, fmt.Sprintf("%s:%v", key.Key, key.Value))
+	}
+	if i.Unique {
+		parts = append(parts, "unique")
+	}
+	if i.ExpireAfter > 0 {
+		parts = append(parts, "ttl="+i.ExpireAfter.String())
+	}
+	return "{" + strings.Join(parts, ", ") + "}"
+}
+
+func (i Index) model() mongo.IndexModel {
+	opts := options.Index().SetName(i.Name())
+	if i.Unique {
+		opts.SetUnique(true)
+	}
+	if i.ExpireAfter > 0 {
+		opts.SetExpireAfterSeconds(int32(i.ExpireAfter.Seconds()))
+	}
+	return mongo.IndexModel{Keys: i.Keys, Options: opts}
+}
+
+// Indexes declares the indexes of the collections of the board storage.
+// Versions older than versionMaxAge and idempotency keys older than
+// idempotencyTTL expire unless those are 0.
+//
+// Boards are listed newest first, so each filter built by storage.build has
+// an index ending in created_at: by owner, by member, and for both at once
+// MongoDB merges the sorted scans of those two indexes. Filters are scoped to
+// the workspaces of the tenant, which prefix those indexes; with several
+// workspaces MongoDB merges the scans of each. Only calls without a tenant
+// list every board, by created_at alone. Lookups by id use _id. Workspaces
+// are listed newest first too, by owner or by member.
+func Indexes(versionMaxAge, idempotencyTTL time.Duration) map[string][]Index {
+	versions := []Index{
+		{Keys: bson.D{{"board_id", 1}, {"version", -1}}, Unique: true},
+	}
+	if versionMaxAge > 0 {
+		versions = append(versions, Index{Keys: bson.D{{"created_at", 1}}, ExpireAfter: versionMaxAge})
+	}
+
+	var idempotencyKeys []Index
+	if idempotencyTTL > 0 {
+		idempotencyKeys = append(idempotencyKeys, Index{Keys: bson.D{{"created_at", 1}}, ExpireAfter: idempotencyTTL})
+	}
+
+	return map[string][]Index{
+		collection: {
+			{Keys: bson.D{{"workspace_id", 1}, {"owner_id", 1}, {"created_at", -1}}},
+			{Keys: bson.D{{"workspace_id", 1}, {"members.member_id", 1}, {"created_at", -1}}},
+			{Keys: bson.D{{"workspace_id", 1}, {"created_at", -1}}},
+			{Keys: bson.D{{"created_at", -1}}},
+		},
+		workspaceCollection: {
+			{Keys: bson.D{{"owner_id", 1}, {"created_at", -1}}},
+			{Keys: bson.D{{"members.member_id", 1}, {"created_at", -1}}},
+			{Keys: bson.D{{"created_at", -1}}},
+		},
+		auditCollection: {
+			{Keys: bson.D{{"board_id", 1}, {"_id", -1}}},
+			{Keys: bson.D{{"workspace_id", 1}, {"_id", -1}}},
+			{Keys: bson.D{{"actor_id", 1}, {"_id", -1}}},
+		},
+		versionCollection:        versions,
+		idempotencydb.Collection: idempotencyKeys,
+	}
+}
+
+// IndexAction is a step of the plan reconciling the indexes of a collection
+// with the declared ones.
+type IndexAction struct {
+	Collection string `json:"collection"`
+	Index      string `json:"index"`
+	Action     string `json:"action"`
+	Spec       string `json:"spec,omitempty"`
+	Reason     string `json:"reason,omitempty"`
+}
+
+type existingIndex struct {
+	Name               string `bson:"name"`
+	Key                bson.D `bson:"key"`
+	Unique             bool   `bson:"unique"`
+	ExpireAfterSeconds *int64 `bson:"expireAfterSeconds"`
+}
+
+func (e existingIndex) spec() Index {
+	i := Index{Keys: e.Key, Unique: e.Unique}
+	if e.ExpireAfterSeconds != nil {
+		i.ExpireAfter = time.Duration(*e.ExpireAfterSeconds) * time.Second
+	}
+	return i
+}
+
+// IndexReconciler creates missing indexes, rebuilds the ones whose spec
+// changed, and reports indexes nobody declared, dropping them only when
+// asked to.
+type IndexReconciler struct {
+	db             *mongo.Database
+	indexes        map[string][]Index
+	dropUnexpected bool
+	log            zerolog.Logger
+}
+
+func NewIndexReconciler(db *mongo.Database, indexes map[string][]Index, dropUnexpected bool, log zerolog.Logger) *IndexReconciler {
+	return &IndexReconciler{
+		db:             db,
+		indexes:        indexes,
+		dropUnexpected: dropUnexpected,
+		log:            log.With().Str("storage", "mongodb").Str("component", "indexes").Logger(),
+	}
+}
+
+// Plan compares the declared indexes with the existing ones without
+// changing anything.
+func (r *IndexReconciler) Plan(ctx context.Context) ([]IndexAction, error) {
+	names := make([]string, 0, len(r.indexes))
+	for name := range r.indexes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var plan []IndexAction
+	for _, name := range names {
+		actions, err := r.plan(ctx, name, r.indexes[name])
+		if err != nil {
+			return nil, err
+		}
+		plan = append(plan, actions...)
+	}
+	return plan, nil
+}
+
+// Apply carries out the plan, stopping at the first failure.
+func (r *IndexReconciler) Apply(ctx context.Context) ([]IndexAction, error) {
+	plan, err := r.Plan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, action := range plan {
+		log := r.log.With().
+			Str("collection", action.Collection).
+			Str("index.name", action.Index).
+			Str("index.action", action.Action).
+			Logger()
+
+		if err = r.apply(ctx, action); err != nil {
+			return plan, fmt.Errorf("index %s.%s not reconciled: %w", action.Collection, action.Index, err)
+		}
+
+		switch action.Action {
+		case IndexKeep:
+			log.Debug().Msg("index up to date")
+		case IndexUnexpected:
+			log.Warn().Str("index.spec", action.Spec).Msg("index not declared")
+		default:
+			log.Info().Str("index.spec", action.Spec).Str("reason", action.Reason).Msg("index reconciled")
+		}
+	}
+
+	return plan, nil
+}
+
+func (r *IndexReconciler) plan(ctx context.Context, name string, declared []Index) ([]IndexAction, error) {
+	existing, err := r.existing(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool, len(declared))
+	for _, index := range declared {
+		seen[index.Name()] = true
+	}
+
+	unexpected := make([]string, 0, len(existing))
+	for index := range existing {
+		if !seen[index] && index != "_id_" {
+			unexpected = append(unexpected, index)
+		}
+	}
+	sort.Strings(unexpected)
+
+	// drops come first, an undeclared index may hold the keys of a declared one
+	var plan []IndexAction
+	for _, index := range unexpected {
+		action := IndexAction{Collection: name, Index: index, Action: IndexUnexpected, Spec: existing[index].spec().String()}
+		if r.dropUnexpected {
+			action.Action = IndexDrop
+			action.Reason = "not declared"
+		}
+		plan = append(plan, action)
+	}
+
+	for _, index := range declared {
+		action := IndexAction{Collection: name, Index: index.Name(), Action: IndexKeep, Spec: index.String()}
+		if current, ok := existing[action.Index]; !ok {
+			action.Action = IndexCreate
+		} else if current.spec().String() != index.String() {
+			action.Action = IndexRebuild
+			action.Reason = "was " + current.spec().String()
+		}
+		plan = append(plan, action)
+	}
+
+	return plan, nil
+}
+
+func (r *IndexReconciler) apply(ctx context.Context, action IndexAction) error {
+	indexes := r.db.Collection(action.Collection).Indexes()
+
+	var declared Index
+	for _, index := range r.indexes[action.Collection] {
+		if index.Name() == action.Index {
+			declared = index
+		}
+	}
+
+	switch action.Action {
+	case IndexCreate:
+		_, err := indexes.CreateOne(ctx, declared.model())
+		return err
+	case IndexRebuild:
+		if _, err := indexes.DropOne(ctx, action.Index); err != nil {
+			return err
+		}
+		_, err := indexes.CreateOne(ctx, declared.model())
+		return err
+	case IndexDrop:
+		_, err := indexes.DropOne(ctx, action.Index)
+		return err
+	}
+	return nil
+}
+
+func (r *IndexReconciler) existing(ctx context.Context, name string) (map[string]existingIndex, error) {
+	cur, err := r.db.Collection(name).Indexes().List(ctx)
+	if err != nil {
+		// listing the indexes of a collection that does not exist yet fails
+		var se mongo.ServerError
+		if errors.As(err, &se) && se.HasErrorCode(namespaceNotFound) {
+			return map[string]existingIndex{}, nil
+		}
+		return nil, fmt.Errorf(mongodb.ErrMsgQuery, err)
+	}
+
+	data, err := mongodb.DecodeAll[existingIndex](ctx, cur)
+	if err != nil {
+		return nil, err
+	}
+
+	existing := make(map[string]existingIndex, len(data))
+	for _, item := range data {
+		existing[item.Name] = item
+	}
+	return existing, nil
+}
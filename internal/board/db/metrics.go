@@ -0,0 +1,24 @@
+package db
+
+import (
+	"github.com/go-funcards/board-service/internal/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"time"
+)
+
+var storageDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: metrics.Namespace,
+	Subsystem: "storage",
+	Name:      "operation_duration_seconds",
+	Help:      "Latency of MongoDB storage operations, by operation and result.",
+	Buckets:   prometheus.DefBuckets,
+}, []string{"operation", "result"})
+
+func observe(operation string, start time.Time, err error) {
+	result := "success"
+	if err != nil {
+		result = "error"
+	}
+	storageDuration.WithLabelValues(operation, result).Observe(time.Since(start).Seconds())
+}
@@ -0,0 +1,276 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-funcards/mongodb"
+	"github.com/rs/zerolog"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"os"
+	"sort"
+	"time"
+)
+
+const (
+	migrationCollection = "schema_migrations"
+	migrationLockID     = "lock"
+	migrationLockLease  = time.Minute
+	migrationLockPoll   = time.Second
+)
+
+// Migration changes the schema or the documents of the database. Up must be
+// safe to run again after a failure, since it is only recorded once it
+// returns without error.
+type Migration struct {
+	Version     uint64
+	Description string
+	Up          func(ctx context.Context, db *mongo.Database) error
+}
+
+type MigrationStatus struct {
+	Version     uint64    `json:"version" bson:"version"`
+	Description string    `json:"description" bson:"description"`
+	Applied     bool      `json:"applied" bson:"-"`
+	AppliedAt   time.Time `json:"applied_at,omitempty" bson:"applied_at,omitempty"`
+	Duration    string    `json:"duration,omitempty" bson:"duration,omitempty"`
+}
+
+// Migrator applies migrations in order of version and records the applied
+// ones in the schema_migrations collection. A lock document in the same
+// collection keeps replicas starting together from migrating twice.
+type Migrator struct {
+	db         *mongo.Database
+	c          *mongo.Collection
+	migrations []Migration
+	owner      string
+	opts       Options
+	log        zerolog.Logger
+}
+
+// NewMigrator bounds its bookkeeping by the "migrate" timeout of opts, the
+// migrations themselves run without one.
+func NewMigrator(db *mongo.Database, opts Options, log zerolog.Logger) *Migrator {
+	sorted := append([]Migration(nil), migrations...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Version < sorted[j].Version
+	})
+
+	host, _ := os.Hostname()
+
+	return &Migrator{
+		db:         db,
+		c:          db.Collection(migrationCollection),
+		migrations: sorted,
+		owner:      fmt.Sprintf("%s/%d/%d", host, os.Getpid(), time.Now().UnixNano()),
+		opts:       opts,
+		log:        log.With().Str("storage", "mongodb").Str("collection", migrationCollection).Logger(),
+	}
+}
+
+// Status lists every known migration, applied or not.
+func (m *Migrator) Status(ctx context.Context) ([]MigrationStatus, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]MigrationStatus, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		item, ok := applied[migration.Version]
+		if !ok {
+			item = MigrationStatus{Version: migration.Version, Description: migration.Description}
+		}
+		item.Applied = ok
+		data = append(data, item)
+	}
+	return data, nil
+}
+
+// Pending lists the migrations Up would apply.
+func (m *Migrator) Pending(ctx context.Context) ([]Migration, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []Migration
+	for _, migration := range m.migrations {
+		if _, ok := applied[migration.Version]; !ok {
+			pending = append(pending, migration)
+		}
+	}
+	return pending, nil
+}
+
+// Up applies the pending migrations while holding the lock, stopping at the
+// first failure. It waits for the lock as long as ctx allows.
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	var done []Migration
+	err := m.Locked(ctx, func(ctx context.Context) (err error) {
+		done, err = m.Apply(ctx)
+		return err
+	})
+	return done, err
+}
+
+// Locked calls fn while holding the lock, so that schema changes of replicas
+// starting together are made once. It waits for the lock as long as ctx
+// allows, and cancels the context of fn when the lock is lost.
+func (m *Migrator) Locked(ctx context.Context, fn func(ctx context.Context) error) error {
+	if err := m.lock(ctx); err != nil {
+		return err
+	}
+	defer m.unlock()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	lost := make(chan error, 1)
+	go m.renew(ctx, func(err error) {
+		lost <- err
+		cancel()
+	})
+
+	err := fn(ctx)
+	select {
+	case errLost := <-lost:
+		return errLost
+	default:
+		return err
+	}
+}
+
+// Apply is Up for a caller already holding the lock through Locked.
+func (m *Migrator) Apply(ctx context.Context) ([]Migration, error) {
+	// the pending migrations are only known for sure under the lock
+	pending, err := m.Pending(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var done []Migration
+	for _, migration := range pending {
+		log := m.log.With().Uint64("migration.version", migration.Version).Str("migration.description", migration.Description).Logger()
+		log.Info().Msg("migration started")
+
+		start := time.Now()
+		if err = migration.Up(ctx, m.db); err != nil {
+			return done, fmt.Errorf("migration %d failed: %w", migration.Version, err)
+		}
+
+		_, err = m.c.InsertOne(ctx, bson.M{
+			"_id":         migration.Version,
+			"version":     migration.Version,
+			"description": migration.Description,
+			"applied_at":  time.Now().UTC(),
+			"duration":    time.Since(start).String(),
+		})
+		if err != nil {
+			return done, fmt.Errorf(mongodb.ErrMsgQuery, err)
+		}
+
+		log.Info().Dur("duration", time.Since(start)).Msg("migration applied")
+
+		done = append(done, migration)
+	}
+
+	return done, nil
+}
+
+func (m *Migrator) applied(ctx context.Context) (map[uint64]MigrationStatus, error) {
+	ctx, cancel := context.WithTimeout(ctx, m.opts.OpTimeout("migrate"))
+	defer cancel()
+
+	cur, err := m.c.Find(ctx, bson.M{"_id": bson.M{"$ne": migrationLockID}})
+	if err != nil {
+		return nil, fmt.Errorf(mongodb.ErrMsgQuery, err)
+	}
+
+	data, err := mongodb.DecodeAll[MigrationStatus](ctx, cur)
+	if err != nil {
+		return nil, err
+	}
+
+	applied := make(map[uint64]MigrationStatus, len(data))
+	for _, item := range data {
+		applied[item.Version] = item
+	}
+	return applied, nil
+}
+
+// lock takes the lock document, or an expired one left by a replica that
+// died while migrating, polling until it is released.
+func (m *Migrator) lock(ctx context.Context) error {
+	ticker := time.NewTicker(migrationLockPoll)
+	defer ticker.Stop()
+
+	for waiting := false; ; {
+		now := time.Now().UTC()
+		_, err := m.c.UpdateOne(ctx,
+			bson.M{"_id": migrationLockID, "expires_at": bson.M{"$lt": now}},
+			bson.M{"$set": bson.M{"owner": m.owner, "expires_at": now.Add(migrationLockLease)}},
+			options.Update().SetUpsert(true),
+		)
+		if err == nil {
+			m.log.Info().Str("owner", m.owner).Msg("migration lock acquired")
+			return nil
+		}
+		if !mongo.IsDuplicateKeyError(err) {
+			return fmt.Errorf(mongodb.ErrMsgQuery, err)
+		}
+
+		if !waiting {
+			waiting = true
+			m.log.Info().Msg("waiting for migration lock held by another replica")
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// renew extends the lease of the lock until ctx is done, calling lost when
+// the lock was taken over.
+func (m *Migrator) renew(ctx context.Context, lost func(err error)) {
+	ticker := time.NewTicker(migrationLockLease / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		result, err := m.c.UpdateOne(ctx,
+			bson.M{"_id": migrationLockID, "owner": m.owner},
+			bson.M{"$set": bson.M{"expires_at": time.Now().UTC().Add(migrationLockLease)}},
+		)
+		if err != nil {
+			m.log.Warn().Err(err).Msg("migration lock not renewed")
+			continue
+		}
+		if result.MatchedCount == 0 {
+			lost(errors.New("migration lock lost"))
+			return
+		}
+	}
+}
+
+func (m *Migrator) unlock() {
+	ctx, cancel := context.WithTimeout(context.Background(), m.opts.OpTimeout("migrate"))
+	defer cancel()
+
+	if _, err := m.c.DeleteOne(ctx, bson.M{"_id": migrationLockID, "owner": m.owner}); err != nil {
+		m.log.Error().Err(err).Msg("migration lock not released")
+		return
+	}
+
+	m.log.Info().Msg("migration lock released")
+}
@@ -0,0 +1,72 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-funcards/mongodb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// migrations are applied in order of version. Released versions must never
+// change: fix a mistake with a new migration instead.
+var migrations = []Migration{
+	{
+		Version:     1,
+		Description: "index boards by owner, creation time and member",
+		Up: func(ctx context.Context, db *mongo.Database) error {
+			_, err := db.Collection(collection).Indexes().CreateOne(ctx, mongo.IndexModel{
+				Keys: bson.D{
+					{"owner_id", 1},
+					{"created_at", 1},
+					{"members.member_id", 1},
+				},
+			})
+			return err
+		},
+	},
+	{
+		Version:     2,
+		Description: "start boards saved before versioning at version 1",
+		Up: func(ctx context.Context, db *mongo.Database) error {
+			_, err := db.Collection(collection).UpdateMany(ctx,
+				bson.M{"version": bson.M{"$exists": false}},
+				bson.M{"$set": bson.M{"version": 1}},
+			)
+			if err != nil {
+				return fmt.Errorf(mongodb.ErrMsgQuery, err)
+			}
+			return nil
+		},
+	},
+	{
+		Version:     3,
+		Description: "drop the index by owner, creation time and member replaced by the declared indexes",
+		Up: func(ctx context.Context, db *mongo.Database) error {
+			_, err := db.Collection(collection).Indexes().DropOne(ctx, "owner_id_1_created_at_1_members.member_id_1")
+			var se mongo.ServerError
+			if errors.As(err, &se) && se.HasErrorCode(indexNotFound) {
+				return nil
+			}
+			return err
+		},
+	},
+	{
+		Version:     4,
+		Description: "drop the indexes by owner and by member replaced by the ones prefixed with the workspace",
+		Up: func(ctx context.Context, db *mongo.Database) error {
+			for _, name := range []string{"owner_id_1_created_at_-1", "members.member_id_1_created_at_-1"} {
+				_, err := db.Collection(collection).Indexes().DropOne(ctx, name)
+				var se mongo.ServerError
+				if errors.As(err, &se) && (se.HasErrorCode(indexNotFound) || se.HasErrorCode(namespaceNotFound)) {
+					continue
+				}
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	},
+}
@@ -2,13 +2,17 @@ package db
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/go-funcards/board-service/internal/board"
+	"github.com/go-funcards/board-service/internal/tracing"
 	"github.com/go-funcards/mongodb"
 	"github.com/go-funcards/slice"
 	"github.com/rs/zerolog"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opentelemetry.io/otel/attribute"
 	"time"
 )
 
@@ -19,162 +23,832 @@ const (
 	collection = "boards"
 )
 
+// Options tune the storages of this package. Timeouts bound every operation,
+// keyed by "save", "save_many", "delete", "delete_many", "find" and "count"
+// for boards and workspaces, and by "audit", "versions", "quota" and "migrate"
+// for the other storages, and fall back to Timeout. An earlier deadline of the
+// incoming call always wins.
+type Options struct {
+	Timeout  time.Duration
+	Timeouts map[string]time.Duration
+	Retry    RetryPolicy
+}
+
+// OpTimeout returns the timeout of op, one of the keys of Timeouts, falling
+// back to Timeout.
+func (o Options) OpTimeout(op string) time.Duration {
+	if t, ok := o.Timeouts[op]; ok && t > 0 {
+		return t
+	}
+	if o.Timeout > 0 {
+		return o.Timeout
+	}
+	return timeout
+}
+
 type storage struct {
-	c   *mongo.Collection
-	log zerolog.Logger
+	c          *mongo.Collection
+	workspaces *mongo.Collection
+	quotas     *quotaStorage
+	opts       Options
+	log        zerolog.Logger
 }
 
-func NewStorage(ctx context.Context, db *mongo.Database, log zerolog.Logger) *storage {
+func NewStorage(db *mongo.Database, quotas *quotaStorage, opts Options, log zerolog.Logger) *storage {
 	s := &storage{
-		c:   db.Collection(collection),
-		log: log.With().Str("storage", "mongodb").Str("collection", collection).Logger(),
+		c:          db.Collection(collection),
+		workspaces: db.Collection(workspaceCollection),
+		quotas:     quotas,
+		opts:       opts,
+		log:        log.With().Str("storage", "mongodb").Str("collection", collection).Logger(),
 	}
-	s.indexes(ctx)
 	return s
 }
 
-func (s *storage) indexes(ctx context.Context) {
-	ctx, cancel := context.WithTimeout(ctx, timeout)
+func (s *storage) Save(ctx context.Context, model board.Board) (err error) {
+	ctx, finish := instrument(ctx, s.c, "save",
+		attribute.String("board.id", model.BoardID),
+		attribute.Int("board.members", len(model.Members)),
+	)
+	defer func() { finish(err) }()
+
+	ctx, cancel := context.WithTimeout(ctx, s.opts.OpTimeout("save"))
+	defer cancel()
+
+	owners, err := s.owners(ctx, []string{model.BoardID})
+	if err != nil {
+		return err
+	}
+
+	a, err := s.admit(ctx, model, owners)
+	if err != nil {
+		return err
+	}
+
+	tracing.Logger(ctx, s.log).Info().Str("board_id", model.BoardID).Msg("board save")
+
+	if err = s.save(ctx, model, a); err != nil {
+		return err
+	}
+
+	tracing.Logger(ctx, s.log).Info().Str("board_id", model.BoardID).Msg("board saved")
+
+	return nil
+}
+
+func (s *storage) SaveMany(ctx context.Context, models []board.Board) (errs []error, err error) {
+	ctx, finish := instrument(ctx, s.c, "save_many", attribute.Int("board.count", len(models)))
+	defer func() { finish(err, attribute.Int("board.errors", countErrors(errs))) }()
+
+	ctx, cancel := context.WithTimeout(ctx, s.opts.OpTimeout("save_many"))
 	defer cancel()
 
-	name, err := s.c.Indexes().CreateOne(ctx, mongo.IndexModel{
-		Keys: bson.D{
-			{"owner_id", 1},
-			{"created_at", 1},
-			{"members.member_id", 1},
-		},
+	errs = make([]error, len(models))
+
+	owners, err := s.owners(ctx, slice.Map(models, func(item board.Board) string {
+		return item.BoardID
+	}))
+	if err != nil {
+		return errs, err
+	}
+
+	tracing.Logger(ctx, s.log).Info().Int("count", len(models)).Msg("boards save")
+
+	// the boards are written one by one, each with the write checking its
+	// limits, and each failing alone like in an unordered bulk write
+	for i, model := range models {
+		a, err := s.admit(ctx, model, owners)
+		if err == nil {
+			err = s.save(ctx, model, a)
+		}
+		if err == nil {
+			continue
+		}
+		if !itemError(err) {
+			return errs, err
+		}
+		errs[i] = err
+	}
+
+	return errs, nil
+}
+
+// save writes model as admitted by a and settles the boards a reserved.
+// The board is read back from the write as it was, which tells whether it
+// was inserted, and the change is recorded from it.
+func (s *storage) save(ctx context.Context, model board.Board, a admission) error {
+	filter, update, err := s.update(ctx, model, a)
+	if err != nil {
+		s.quotas.release(ctx, a.reserved, 1)
+		return err
+	}
+
+	// the update is only retried when it was not applied
+	var current *board.Board
+	err = s.opts.Retry.Do(ctx, false, func(ctx context.Context) error {
+		current = nil
+		b, err := mongodb.DecodeOne[board.Board](s.c.FindOneAndUpdate(ctx, filter, update,
+			options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.Before)))
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil
+		}
+		if err == nil {
+			current = &b
+		}
+		return err
 	})
 	if err != nil {
-		s.log.Fatal().Err(err).Msg("index not created")
+		s.quotas.release(ctx, a.reserved, 1)
+		if mongo.IsDuplicateKeyError(err) {
+			return s.rejected(ctx, model, a)
+		}
+		return err
 	}
+	switch {
+	case a.replacing:
+		s.quotas.release(ctx, a.transferred(), 1)
+	case current != nil:
+		// the board was created concurrently, it is not new after all
+		s.quotas.release(ctx, a.reserved, 1)
+	}
+
+	next := board.Merge(current, model)
 
-	s.log.Info().Str("index.name", name).Msg("index created")
+	// a new board is only kept in a workspace which was not deleted meanwhile,
+	// see workspaceStorage.Delete
+	if current == nil {
+		if err = active(ctx, s.workspaces, s.opts.Retry, next.WorkspaceID); err != nil {
+			s.takeBack(ctx, next.BoardID, bson.M{"_id": next.BoardID, "version": next.Version}, nil, func() {
+				s.quotas.release(ctx, a.reserved, 1)
+			})
+			return err
+		}
+	}
+
+	board.RecordChange(ctx, current, &next)
+
+	return nil
 }
 
-func (s *storage) Save(ctx context.Context, model board.Board) error {
-	var write []mongo.WriteModel
-	data, err := mongodb.ToBson(model)
+// takeBack undoes a write of the board matching filter: it is deleted when
+// update is nil, updated otherwise. A board changed again meanwhile is left as
+// it is, undone is only called when the board was taken back.
+func (s *storage) takeBack(ctx context.Context, id string, filter bson.M, update bson.M, undone func()) {
+	var n int64
+	err := s.opts.Retry.Do(ctx, false, func(ctx context.Context) error {
+		if update == nil {
+			result, err := s.c.DeleteOne(ctx, filter)
+			if err == nil {
+				n = result.DeletedCount
+			}
+			return err
+		}
+		result, err := s.c.UpdateOne(ctx, filter, update)
+		if err == nil {
+			n = result.ModifiedCount
+		}
+		return err
+	})
+	if err != nil || n == 0 {
+		tracing.Logger(ctx, s.log).Error().Err(err).Str("board_id", id).Msg("board write not taken back")
+		return
+	}
+	if undone != nil {
+		undone()
+	}
+}
+
+// admission is what a board may be written with: the member limit guarding
+// the write and the owner a board was reserved for, if any. An existing board
+// being replaced is only written while it still has its previous owner.
+type admission struct {
+	maxMembers uint64
+	reserved   string
+	replacing  bool
+	previous   string
+}
+
+// transferred returns the owner a replaced board was taken from, whose board
+// is released once the write succeeded.
+func (a admission) transferred() string {
+	if len(a.reserved) == 0 {
+		return ""
+	}
+	return a.previous
+}
+
+// rejected is the error of a write of model that found no board to update
+// and could not insert one.
+func (a admission) rejected(model board.Board) error {
+	if a.replacing {
+		return board.Conflict(board.ResourceBoard, model.BoardID, "board changed owner during the write")
+	}
+	return board.MemberQuotaExceeded(model.BoardID, a.maxMembers)
+}
+
+// rejected is the error of a write of model that found no board to update
+// and could not insert one. With a tenant, the board may also exist outside
+// of its workspaces, or have been moved out of them during the write.
+func (s *storage) rejected(ctx context.Context, model board.Board, a admission) error {
+	if _, ok := board.TenantFromContext(ctx); !ok {
+		return a.rejected(model)
+	}
+
+	var current board.Board
+	err := s.opts.Retry.Do(ctx, true, func(ctx context.Context) (err error) {
+		current, err = mongodb.DecodeOne[board.Board](s.c.FindOne(ctx, bson.M{"_id": model.BoardID},
+			options.FindOne().SetProjection(bson.M{"workspace_id": 1})))
+		return err
+	})
+	switch {
+	case err != nil && !errors.Is(err, mongo.ErrNoDocuments):
+		return err
+	case err == nil && !board.Allowed(ctx, current.WorkspaceID):
+		return board.NotFound(board.ResourceBoard, model.BoardID, nil)
+	case !a.replacing && a.maxMembers == 0:
+		// the member limit did not keep the board from matching
+		return board.Conflict(board.ResourceBoard, model.BoardID, "board changed workspace during the write")
+	}
+	return a.rejected(model)
+}
+
+// scoped restricts filter to the boards of the workspaces of the tenant of
+// ctx, if any, so that a write does not reach the boards of other tenants
+// even when they changed since NewTenantStorage checked them.
+func scoped(ctx context.Context, filter bson.M) bson.M {
+	if t, ok := board.TenantFromContext(ctx); ok {
+		filter["workspace_id"] = bson.M{"$in": t.WorkspaceIDs}
+	}
+	return filter
+}
+
+// admit checks the limits of the board's owner, reserving a board for it when
+// model is new or replaces the owner. The member limit of existing boards is
+// enforced by the write, unless their members are replaced.
+func (s *storage) admit(ctx context.Context, model board.Board, owners map[string]string) (admission, error) {
+	current, exists := owners[model.BoardID]
+	ownerID := current
+	if !exists || model.Replace {
+		ownerID = model.OwnerID
+	}
+
+	limits, err := s.quotas.Limits(ctx, ownerID)
 	if err != nil {
+		return admission{}, err
+	}
+
+	a := admission{maxMembers: limits.MaxMembers, replacing: exists && model.Replace, previous: current}
+	if (!exists || model.Replace) && a.maxMembers > 0 && uint64(len(memberIDs(model.Members))) > a.maxMembers {
+		return a, board.MemberQuotaExceeded(model.BoardID, a.maxMembers)
+	}
+	if (exists && ownerID == current) || len(ownerID) == 0 {
+		return a, nil
+	}
+
+	if err = s.quotas.reserve(ctx, ownerID, limits.MaxBoards); err != nil {
+		return a, err
+	}
+	a.reserved = ownerID
+
+	return a, nil
+}
+
+// owners returns the owner of every existing board among ids.
+func (s *storage) owners(ctx context.Context, ids []string) (map[string]string, error) {
+	var data []board.Board
+	err := s.opts.Retry.Do(ctx, true, func(ctx context.Context) error {
+		cur, err := s.c.Find(ctx, bson.M{"_id": bson.M{"$in": ids}}, options.Find().SetProjection(bson.M{"owner_id": 1}))
+		if err != nil {
+			return fmt.Errorf(mongodb.ErrMsgQuery, err)
+		}
+		data, err = mongodb.DecodeAll[board.Board](ctx, cur)
 		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	owners := make(map[string]string, len(data))
+	for _, item := range data {
+		owners[item.BoardID] = item.OwnerID
+	}
+	return owners, nil
+}
+
+func memberIDs(members []board.Member) []string {
+	set := make(map[string]bool, len(members))
+	for _, item := range members {
+		if !item.Delete {
+			set[item.MemberID] = true
+		}
+	}
+
+	ids := make([]string, 0, len(set))
+	for id := range set {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// update builds the filter and the update saving model. It is a single pipeline update
+// so that changed members are replaced in the same write that checks the
+// limit: with a member limit set, the upsert only matches an existing board
+// while its members stay within the limit once saved; otherwise it fails with
+// a duplicate key and nothing is written. A board being replaced is matched
+// by its previous owner the same way.
+func (s *storage) update(ctx context.Context, model board.Board, a admission) (bson.M, mongo.Pipeline, error) {
+	data, err := mongodb.ToBson(model)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	delete(data, "_id")
+	delete(data, "workspace_id")
 	delete(data, "owner_id")
 	delete(data, "created_at")
 	delete(data, "members")
+	delete(data, "version")
 
-	if deleteMembers := slice.Map(model.Members, func(item board.Member) string {
+	changed := slice.Map(model.Members, func(item board.Member) string {
 		return item.MemberID
-	}); len(deleteMembers) > 0 {
-		s.log.Info().
+	})
+	if len(changed) > 0 {
+		tracing.Logger(ctx, s.log).Info().
 			Str("board_id", model.BoardID).
-			Strs("members", deleteMembers).
-			Msg("delete board's members")
-
-		write = append(write, mongo.
-			NewUpdateOneModel().
-			SetFilter(bson.M{"_id": model.BoardID}).
-			SetUpdate(bson.M{
-				"$pull": bson.M{
-					"members": bson.M{
-						"member_id": bson.M{
-							"$in": deleteMembers,
-						},
-					},
-				},
-			}),
-		)
+			Strs("members", changed).
+			Msg("replace board's members")
 	}
 
+	added := make(map[string]bool)
 	addMembers := slice.Filter(model.Members, func(item board.Member) bool {
-		return !item.Delete
+		if item.Delete || added[item.MemberID] {
+			return false
+		}
+		added[item.MemberID] = true
+		return true
 	})
 
-	write = append(write, mongo.
-		NewUpdateOneModel().
-		SetUpsert(true).
-		SetFilter(bson.M{"_id": model.BoardID}).
-		SetUpdate(bson.M{
-			"$set": data,
-			"$setOnInsert": bson.M{
-				"owner_id":   model.OwnerID,
-				"created_at": model.CreatedAt,
-			},
-			"$addToSet": bson.M{
-				"members": bson.M{"$each": addMembers},
-			},
-		}),
-	)
+	// the members left after removing the changed ones
+	kept := bson.M{"$filter": bson.M{
+		"input": bson.M{"$ifNull": bson.A{"$members", bson.A{}}},
+		"cond":  bson.M{"$not": bson.A{bson.M{"$in": bson.A{"$$this.member_id", bson.M{"$literal": changed}}}}},
+	}}
+
+	if model.Replace {
+		set := bson.M{
+			"owner_id":   bson.M{"$literal": model.OwnerID},
+			"name":       bson.M{"$literal": model.Name},
+			"metadata":   bson.M{"$literal": model.Metadata},
+			"created_at": bson.M{"$ifNull": bson.A{"$created_at", bson.M{"$literal": model.CreatedAt}}},
+			"members":    bson.M{"$literal": addMembers},
+			"version":    bson.M{"$add": bson.A{bson.M{"$ifNull": bson.A{"$version", bson.M{"$literal": model.Version}}}, 1}},
+		}
+		if len(model.WorkspaceID) > 0 {
+			set["workspace_id"] = bson.M{"$ifNull": bson.A{"$workspace_id", bson.M{"$literal": model.WorkspaceID}}}
+		}
 
-	s.log.Info().Str("board_id", model.BoardID).Msg("board save")
+		filter := scoped(ctx, bson.M{"_id": model.BoardID})
+		if a.replacing {
+			filter["owner_id"] = a.previous
+			if len(a.previous) == 0 {
+				filter["owner_id"] = bson.M{"$in": bson.A{"", nil}}
+			}
+		}
 
-	ctx, cancel := context.WithTimeout(ctx, timeout)
+		return filter, mongo.Pipeline{{{"$set", set}}}, nil
+	}
+
+	filter := scoped(ctx, bson.M{"_id": model.BoardID})
+	if maxMembers := a.maxMembers; maxMembers > 0 && len(addMembers) > 0 {
+		filter["$expr"] = bson.M{"$lte": bson.A{
+			bson.M{"$size": bson.M{"$setUnion": bson.A{
+				bson.M{"$setDifference": bson.A{
+					bson.M{"$ifNull": bson.A{"$members.member_id", bson.A{}}},
+					bson.M{"$literal": changed},
+				}},
+				bson.M{"$literal": memberIDs(addMembers)},
+			}}},
+			maxMembers,
+		}}
+	}
+
+	// values are literals, a name starting with $ is not a field path
+	set := bson.M{
+		"owner_id":   bson.M{"$ifNull": bson.A{"$owner_id", bson.M{"$literal": model.OwnerID}}},
+		"created_at": bson.M{"$ifNull": bson.A{"$created_at", bson.M{"$literal": model.CreatedAt}}},
+		"members":    bson.M{"$concatArrays": bson.A{kept, bson.M{"$literal": addMembers}}},
+		"version":    bson.M{"$add": bson.A{bson.M{"$ifNull": bson.A{"$version", bson.M{"$literal": model.Version}}}, 1}},
+	}
+	if len(model.WorkspaceID) > 0 {
+		set["workspace_id"] = bson.M{"$ifNull": bson.A{"$workspace_id", bson.M{"$literal": model.WorkspaceID}}}
+	}
+	for k, v := range data {
+		set[k] = bson.M{"$literal": v}
+	}
+
+	return filter, mongo.Pipeline{{{"$set", set}}}, nil
+}
+
+func (s *storage) Delete(ctx context.Context, id string) (err error) {
+	ctx, finish := instrument(ctx, s.c, "delete", attribute.String("board.id", id))
+	defer func() { finish(err) }()
+
+	ctx, cancel := context.WithTimeout(ctx, s.opts.OpTimeout("delete"))
 	defer cancel()
 
-	result, err := s.c.BulkWrite(ctx, write)
+	tracing.Logger(ctx, s.log).Debug().Str("board_id", id).Msg("board delete")
+	err = s.opts.Retry.Do(ctx, false, func(ctx context.Context) error {
+		return s.remove(ctx, id)
+	})
 	if err != nil {
-		return fmt.Errorf(fmt.Sprintf("board save: %s", mongodb.ErrMsgQuery), err)
+		return err
 	}
+	tracing.Logger(ctx, s.log).Debug().Str("board_id", id).Msg("board deleted")
 
-	s.log.Info().Str("board_id", model.BoardID).Interface("result", result).Msg("board saved")
+	return nil
+}
+
+func (s *storage) Transfer(ctx context.Context, id, ownerID string) (err error) {
+	ctx, finish := instrument(ctx, s.c, "transfer",
+		attribute.String("board.id", id),
+		attribute.String("board.owner_id", ownerID),
+	)
+	defer func() { finish(err) }()
+
+	ctx, cancel := context.WithTimeout(ctx, s.opts.OpTimeout("save"))
+	defer cancel()
+
+	var current board.Board
+	err = s.opts.Retry.Do(ctx, true, func(ctx context.Context) (err error) {
+		current, err = mongodb.DecodeOne[board.Board](s.c.FindOne(ctx, scoped(ctx, bson.M{"_id": id}),
+			options.FindOne().SetProjection(bson.M{"owner_id": 1, "members": 1})))
+		return err
+	})
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return board.NotFound(board.ResourceBoard, id, mongo.ErrNoDocuments)
+	}
+	if err != nil {
+		return err
+	}
+	if current.OwnerID == ownerID {
+		return nil
+	}
+
+	limits, err := s.quotas.Limits(ctx, ownerID)
+	if err != nil {
+		return err
+	}
+	if limits.MaxMembers > 0 && uint64(len(current.Members)) > limits.MaxMembers {
+		return board.MemberQuotaExceeded(id, limits.MaxMembers)
+	}
+	if err = s.quotas.reserve(ctx, ownerID, limits.MaxBoards); err != nil {
+		return err
+	}
+
+	tracing.Logger(ctx, s.log).Info().Str("board_id", id).Str("owner_id", ownerID).Msg("board transfer")
+
+	// the owner read above must still be the owner, or the counters would drift
+	filter := scoped(ctx, bson.M{"_id": id, "owner_id": current.OwnerID})
+	if len(current.OwnerID) == 0 {
+		filter["owner_id"] = nil
+	}
+
+	var next board.Board
+	err = s.opts.Retry.Do(ctx, false, func(ctx context.Context) (err error) {
+		next, err = mongodb.DecodeOne[board.Board](s.c.FindOneAndUpdate(ctx, filter,
+			bson.M{"$set": bson.M{"owner_id": ownerID}, "$inc": bson.M{"version": 1}},
+			options.FindOneAndUpdate().SetReturnDocument(options.After)))
+		return err
+	})
+	if err != nil {
+		s.quotas.release(ctx, ownerID, 1)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return board.Conflict(board.ResourceBoard, id, "board changed owner or was deleted during the transfer")
+		}
+		return err
+	}
+	s.quotas.release(ctx, current.OwnerID, 1)
+
+	// the write changed nothing else than the owner and the version
+	previous := next
+	previous.OwnerID, previous.Version = current.OwnerID, next.Version-1
+	board.RecordChange(ctx, &previous, &next)
 
 	return nil
 }
 
-func (s *storage) Delete(ctx context.Context, id string) error {
-	ctx, cancel := context.WithTimeout(ctx, timeout)
+func (s *storage) Move(ctx context.Context, id, workspaceID string) (err error) {
+	ctx, finish := instrument(ctx, s.c, "move",
+		attribute.String("board.id", id),
+		attribute.String("board.workspace_id", workspaceID),
+	)
+	defer func() { finish(err) }()
+
+	ctx, cancel := context.WithTimeout(ctx, s.opts.OpTimeout("save"))
 	defer cancel()
 
-	s.log.Debug().Str("board_id", id).Msg("board delete")
-	result, err := s.c.DeleteOne(ctx, bson.M{"_id": id})
+	var current board.Board
+	err = s.opts.Retry.Do(ctx, true, func(ctx context.Context) (err error) {
+		current, err = mongodb.DecodeOne[board.Board](s.c.FindOne(ctx, scoped(ctx, bson.M{"_id": id}),
+			options.FindOne().SetProjection(bson.M{"workspace_id": 1})))
+		return err
+	})
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return board.NotFound(board.ResourceBoard, id, mongo.ErrNoDocuments)
+	}
 	if err != nil {
-		return fmt.Errorf(mongodb.ErrMsgQuery, err)
+		return err
+	}
+	if current.WorkspaceID == workspaceID {
+		return nil
 	}
-	if result.DeletedCount == 0 {
-		return fmt.Errorf(mongodb.ErrMsgQuery, mongo.ErrNoDocuments)
+	if !board.Allowed(ctx, workspaceID) {
+		return board.PermissionDenied(board.ResourceWorkspace, workspaceID, "workspace is not available to the caller")
 	}
-	s.log.Debug().Str("board_id", id).Msg("board deleted")
+
+	tracing.Logger(ctx, s.log).Info().Str("board_id", id).Str("workspace_id", workspaceID).Msg("board move")
+
+	// boards outside of any workspace have no workspace_id, which null matches;
+	// the workspace read above is one of the tenant
+	filter := bson.M{"_id": id, "workspace_id": current.WorkspaceID}
+	if len(current.WorkspaceID) == 0 {
+		filter["workspace_id"] = nil
+	}
+
+	var next board.Board
+	err = s.opts.Retry.Do(ctx, false, func(ctx context.Context) (err error) {
+		next, err = mongodb.DecodeOne[board.Board](s.c.FindOneAndUpdate(ctx, filter,
+			bson.M{"$set": bson.M{"workspace_id": workspaceID}, "$inc": bson.M{"version": 1}},
+			options.FindOneAndUpdate().SetReturnDocument(options.After)))
+		return err
+	})
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return board.Conflict(board.ResourceBoard, id, "board changed workspace or was deleted during the move")
+	}
+	if err != nil {
+		return err
+	}
+
+	// the board is only kept in a workspace which was not deleted meanwhile
+	if err = active(ctx, s.workspaces, s.opts.Retry, workspaceID); err != nil {
+		undo := bson.M{"$set": bson.M{"workspace_id": current.WorkspaceID, "version": next.Version - 1}}
+		if len(current.WorkspaceID) == 0 {
+			undo = bson.M{"$set": bson.M{"version": next.Version - 1}, "$unset": bson.M{"workspace_id": ""}}
+		}
+		s.takeBack(ctx, id, bson.M{"_id": id, "workspace_id": workspaceID, "version": next.Version}, undo, nil)
+		return err
+	}
+
+	// the write changed nothing else than the workspace and the version
+	previous := next
+	previous.WorkspaceID, previous.Version = current.WorkspaceID, next.Version-1
+	board.RecordChange(ctx, &previous, &next)
 
 	return nil
 }
 
-func (s *storage) Find(ctx context.Context, filter board.Filter, index uint64, size uint32) ([]board.Board, error) {
-	ctx, cancel := context.WithTimeout(ctx, timeout)
+// Restore inserts models as they are with an unordered bulk write. Their owners
+// are counted without limit, the boards were within it when backed up.
+func (s *storage) Restore(ctx context.Context, models []board.Board) (errs []error, err error) {
+	ctx, finish := instrument(ctx, s.c, "restore", attribute.Int("board.count", len(models)))
+	defer func() { finish(err, attribute.Int("board.errors", countErrors(errs))) }()
+
+	ctx, cancel := context.WithTimeout(ctx, s.opts.OpTimeout("save_many"))
 	defer cancel()
 
-	opts := mongodb.FindOptions(index, size).SetSort(bson.D{{"created_at", -1}})
-	cur, err := s.c.Find(ctx, s.build(filter), opts)
+	errs = make([]error, len(models))
+
+	var write []mongo.WriteModel
+	items := make([]int, 0, len(models))
+	for i, model := range models {
+		if err = s.quotas.reserve(ctx, model.OwnerID, 0); err != nil {
+			errs[i] = err
+			continue
+		}
+		items = append(items, i)
+		write = append(write, mongo.NewInsertOneModel().SetDocument(model))
+	}
+
+	tracing.Logger(ctx, s.log).Info().Int("count", len(models)).Msg("boards restore")
+
+	_, err = s.bulkWrite(ctx, write, items, errs, func(i int) error {
+		return board.AlreadyExists(board.ResourceBoard, models[i].BoardID, nil)
+	})
+
+	for _, i := range items {
+		if err != nil || errs[i] != nil {
+			s.quotas.release(ctx, models[i].OwnerID, 1)
+		}
+	}
+
+	return errs, err
+}
+
+func (s *storage) DeleteMany(ctx context.Context, ids []string) (errs []error, err error) {
+	ctx, finish := instrument(ctx, s.c, "delete_many", attribute.Int("board.count", len(ids)))
+	defer func() { finish(err, attribute.Int("board.errors", countErrors(errs))) }()
+
+	ctx, cancel := context.WithTimeout(ctx, s.opts.OpTimeout("delete_many"))
+	defer cancel()
+
+	errs = make([]error, len(ids))
+
+	tracing.Logger(ctx, s.log).Debug().Strs("board_ids", ids).Msg("boards delete")
+
+	for i, id := range ids {
+		err = s.opts.Retry.Do(ctx, false, func(ctx context.Context) error {
+			return s.remove(ctx, id)
+		})
+		if err == nil {
+			continue
+		}
+		if !itemError(err) {
+			return errs, err
+		}
+		errs[i] = err
+	}
+
+	return errs, nil
+}
+
+// remove deletes the board, releases it from its owner and records the
+// change from the board the write returned.
+func (s *storage) remove(ctx context.Context, id string) error {
+	deleted, err := mongodb.DecodeOne[board.Board](s.c.FindOneAndDelete(ctx, scoped(ctx, bson.M{"_id": id})))
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return board.NotFound(board.ResourceBoard, id, mongo.ErrNoDocuments)
+	}
 	if err != nil {
-		return nil, fmt.Errorf(mongodb.ErrMsgQuery, err)
+		return err
+	}
+	s.quotas.release(ctx, deleted.OwnerID, 1)
+	board.RecordChange(ctx, &deleted, nil)
+	return nil
+}
+
+// itemError reports whether err failed the write of a single board of a
+// batch, rather than the batch.
+func itemError(err error) bool {
+	var e *board.Error
+	var we mongo.WriteException
+	return errors.As(err, &e) || errors.As(err, &we)
+}
+
+// bulkWrite executes write as a single unordered bulk operation, one write
+// model per batch item. items maps every write model to the position of the
+// item it belongs to, so that write errors are reported in errs while the
+// other items are written. A duplicate key error of an item is replaced by
+// duplicate(item) when given.
+func (s *storage) bulkWrite(ctx context.Context, write []mongo.WriteModel, items []int, errs []error, duplicate func(int) error) (*mongo.BulkWriteResult, error) {
+	if len(write) == 0 {
+		return nil, nil
+	}
+
+	result, err := s.c.BulkWrite(ctx, write, options.BulkWrite().SetOrdered(false))
+	if err == nil {
+		tracing.Logger(ctx, s.log).Info().Interface("result", result).Msg("bulk write executed")
+		return result, nil
+	}
+
+	var bwe mongo.BulkWriteException
+	if !errors.As(err, &bwe) || bwe.WriteConcernError != nil || len(bwe.WriteErrors) == 0 {
+		return result, fmt.Errorf(mongodb.ErrMsgQuery, err)
+	}
+
+	for _, we := range bwe.WriteErrors {
+		i := items[we.Index]
+		errs[i] = fmt.Errorf(mongodb.ErrMsgQuery, we)
+		if duplicate != nil && mongo.IsDuplicateKeyError(we) {
+			errs[i] = duplicate(i)
+		}
+	}
+
+	tracing.Logger(ctx, s.log).Warn().Err(err).Int("failed", len(bwe.WriteErrors)).Msg("bulk write partially failed")
+
+	return result, nil
+}
+
+func (s *storage) Find(ctx context.Context, filter board.Filter, index uint64, size uint32) (data []board.Board, err error) {
+	ctx, finish := instrument(ctx, s.c, "find", append(filterAttributes(filter),
+		attribute.Int64("board.page.index", int64(index)),
+		attribute.Int64("board.page.size", int64(size)),
+	)...)
+	defer func() { finish(err, attribute.Int("board.results", len(data))) }()
+
+	ctx, cancel := context.WithTimeout(ctx, s.opts.OpTimeout("find"))
+	defer cancel()
+
+	opts := mongodb.FindOptions(index, size).SetSort(bson.D{{"created_at", -1}})
+	if len(filter.Fields) > 0 {
+		opts.SetProjection(s.projection(filter.Fields))
 	}
-	return mongodb.DecodeAll[board.Board](ctx, cur)
+	err = s.opts.Retry.Do(ctx, true, func(ctx context.Context) error {
+		cur, err := s.c.Find(ctx, s.build(filter), opts)
+		if err != nil {
+			return fmt.Errorf(mongodb.ErrMsgQuery, err)
+		}
+		data, err = mongodb.DecodeAll[board.Board](ctx, cur)
+		return err
+	})
+	return data, err
 }
 
-func (s *storage) Count(ctx context.Context, filter board.Filter) (uint64, error) {
-	ctx, cancel := context.WithTimeout(ctx, timeout)
+func (s *storage) Count(ctx context.Context, filter board.Filter) (total uint64, err error) {
+	ctx, finish := instrument(ctx, s.c, "count", filterAttributes(filter)...)
+	defer func() { finish(err, attribute.Int64("board.total", int64(total))) }()
+
+	ctx, cancel := context.WithTimeout(ctx, s.opts.OpTimeout("count"))
 	defer cancel()
 
-	total, err := s.c.CountDocuments(ctx, s.build(filter))
+	var n int64
+	err = s.opts.Retry.Do(ctx, true, func(ctx context.Context) (err error) {
+		n, err = s.c.CountDocuments(ctx, s.build(filter))
+		return err
+	})
 	if err != nil {
 		return 0, fmt.Errorf(mongodb.ErrMsgQuery, err)
 	}
-	return uint64(total), nil
+	return uint64(n), nil
+}
+
+// Stats counts the boards and their members with a single aggregation over
+// the collection.
+func (s *storage) Stats(ctx context.Context) (stats board.Stats, err error) {
+	ctx, finish := instrument(ctx, s.c, "stats")
+	defer func() { finish(err) }()
+
+	ctx, cancel := context.WithTimeout(ctx, s.opts.OpTimeout("count"))
+	defer cancel()
+
+	err = s.opts.Retry.Do(ctx, true, func(ctx context.Context) error {
+		cur, err := s.c.Aggregate(ctx, mongo.Pipeline{
+			{{"$group", bson.M{
+				"_id":     nil,
+				"boards":  bson.M{"$sum": 1},
+				"members": bson.M{"$sum": bson.M{"$size": bson.M{"$ifNull": bson.A{"$members", bson.A{}}}}},
+			}}},
+		})
+		if err != nil {
+			return fmt.Errorf(mongodb.ErrMsgQuery, err)
+		}
+		defer cur.Close(ctx)
+
+		var data struct {
+			Boards  int64 `bson:"boards"`
+			Members int64 `bson:"members"`
+		}
+		if cur.Next(ctx) {
+			if err = cur.Decode(&data); err != nil {
+				return err
+			}
+		}
+		stats = board.Stats{Boards: uint64(data.Boards), Members: uint64(data.Members)}
+		return cur.Err()
+	})
+	return stats, err
 }
 
 func (s *storage) build(filter board.Filter) any {
 	f := make(mongodb.Filter, 0)
 	if len(filter.BoardIDs) > 0 {
-		f = append(f, mongodb.In("_id", filter.BoardIDs))
-	}
-	if len(filter.OwnerIDs) > 0 && len(filter.MemberIDs) > 0 {
-		f = append(f, mongodb.Or(
-			mongodb.In("owner_id", filter.OwnerIDs),
-			mongodb.In("members.member_id", filter.MemberIDs),
-		))
-	} else if len(filter.OwnerIDs) > 0 {
-		f = append(f, mongodb.In("owner_id", filter.OwnerIDs))
-	} else if len(filter.MemberIDs) > 0 {
-		f = append(f, mongodb.In("members.member_id", filter.MemberIDs))
+		f = append(f, in("_id", filter.BoardIDs))
+	}
+	if len(filter.WorkspaceIDs) > 0 {
+		f = append(f, in("workspace_id", filter.WorkspaceIDs))
+	}
+	var or []mongodb.Expr
+	if len(filter.OwnerIDs) > 0 {
+		or = append(or, in("owner_id", filter.OwnerIDs))
+	}
+	if len(filter.MemberIDs) > 0 {
+		or = append(or, in("members.member_id", filter.MemberIDs))
+	}
+	if len(filter.MemberWorkspaceIDs) > 0 {
+		or = append(or, in("workspace_id", filter.MemberWorkspaceIDs))
+	}
+	if len(or) > 1 {
+		f = append(f, mongodb.Or(or...))
+	} else if len(or) == 1 {
+		f = append(f, or[0])
 	}
 	return f.Build()
 }
+
+// in matches name against any of values. mongodb.In takes the values one by
+// one: a slice passed as is would be a single value, matching only arrays.
+func in(name string, values []string) mongodb.Expr {
+	return mongodb.In(name, slice.Map(values, func(v string) any {
+		return v
+	})...)
+}
+
+func (s *storage) projection(fields []string) bson.M {
+	p := bson.M{"_id": 0}
+	for _, field := range fields {
+		if field == "board_id" {
+			field = "_id"
+		}
+		p[field] = 1
+	}
+	return p
+}
@@ -0,0 +1,72 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-funcards/board-service/internal/board"
+	"github.com/go-funcards/board-service/internal/board/storagetest"
+	"github.com/rs/zerolog"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"os"
+	"testing"
+	"time"
+)
+
+// TestStorage runs against the server at MONGODB_URI, in a database of its
+// own per test which is dropped afterwards.
+func TestStorage(t *testing.T) {
+	client := connect(t)
+
+	storagetest.Run(t, func(t *testing.T) storagetest.Storages {
+		return open(t, client, board.Limits{})
+	})
+}
+
+func TestStorageLimits(t *testing.T) {
+	client := connect(t)
+
+	storagetest.RunLimits(t, func(t *testing.T, limits board.Limits) storagetest.Storages {
+		return open(t, client, limits)
+	})
+}
+
+func TestWorkspaceStorage(t *testing.T) {
+	client := connect(t)
+
+	storagetest.RunWorkspaces(t, func(t *testing.T) storagetest.Storages {
+		return open(t, client, board.Limits{})
+	})
+}
+
+func open(t *testing.T, client *mongo.Client, limits board.Limits) storagetest.Storages {
+	db := database(t, client)
+
+	log := zerolog.Nop()
+	quotas := NewQuotaStorage(db, limits, Options{}, log)
+	return storagetest.Storages{
+		Boards:     NewStorage(db, quotas, Options{}, log),
+		Workspaces: NewWorkspaceStorage(db, Options{}, log),
+		Quotas:     quotas,
+	}
+}
+
+func connect(t *testing.T) *mongo.Client {
+	uri := os.Getenv("MONGODB_URI")
+	if len(uri) == 0 {
+		t.Skip("MONGODB_URI not set")
+	}
+
+	client, err := mongo.Connect(context.Background(), options.Client().ApplyURI(uri))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = client.Disconnect(context.Background()) })
+	return client
+}
+
+func database(t *testing.T, client *mongo.Client) *mongo.Database {
+	db := client.Database(fmt.Sprintf("board_service_test_%d", time.Now().UnixNano()))
+	t.Cleanup(func() { _ = db.Drop(context.Background()) })
+	return db
+}
@@ -0,0 +1,161 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/go-funcards/board-service/internal/board"
+	"github.com/go-funcards/board-service/internal/board/db"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/rs/zerolog"
+	"strconv"
+	"strings"
+)
+
+var _ board.AuditStorage = (*auditStorage)(nil)
+
+type auditStorage struct {
+	pool *pgxpool.Pool
+	opts db.Options
+	log  zerolog.Logger
+}
+
+func NewAuditStorage(pool *pgxpool.Pool, opts db.Options, log zerolog.Logger) *auditStorage {
+	return &auditStorage{
+		pool: pool,
+		opts: opts,
+		log:  log.With().Str("storage", "postgres").Str("table", "board_audit").Logger(),
+	}
+}
+
+func (s *auditStorage) Append(ctx context.Context, entries ...board.AuditEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.opts.OpTimeout("audit"))
+	defer cancel()
+
+	batch := new(pgx.Batch)
+	for _, entry := range entries {
+		before, err := json.Marshal(entry.Before)
+		if err != nil {
+			return err
+		}
+		after, err := json.Marshal(entry.After)
+		if err != nil {
+			return err
+		}
+		batch.Queue(`
+			INSERT INTO board_audit (board_id, workspace_id, actor_id, action, request_id, before, after, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+			entry.BoardID, entry.WorkspaceID, entry.ActorID, entry.Action, entry.RequestID,
+			string(before), string(after), entry.CreatedAt,
+		)
+	}
+
+	// the batch runs in an implicit transaction, entries are appended together or not at all
+	if err := s.pool.SendBatch(ctx, batch).Close(); err != nil {
+		return fmt.Errorf(ErrMsgQuery, err)
+	}
+
+	s.log.Debug().Int("count", len(entries)).Msg("audit entries appended")
+
+	return nil
+}
+
+// Find lists entries newest first, starting before filter.AfterID when set.
+// Audit ids are the decimal sequence numbers of the entries.
+func (s *auditStorage) Find(ctx context.Context, filter board.AuditFilter, size uint32) ([]board.AuditEntry, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.opts.OpTimeout("audit"))
+	defer cancel()
+
+	var conds []string
+	var args []any
+	arg := func(v any) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if len(filter.BoardID) > 0 {
+		conds = append(conds, "board_id = "+arg(filter.BoardID))
+	}
+	if len(filter.WorkspaceIDs) > 0 {
+		conds = append(conds, fmt.Sprintf("workspace_id = ANY(%s)", arg(filter.WorkspaceIDs)))
+	}
+	if len(filter.ActorID) > 0 {
+		conds = append(conds, "actor_id = "+arg(filter.ActorID))
+	}
+	if !filter.From.IsZero() {
+		conds = append(conds, "created_at >= "+arg(filter.From))
+	}
+	if !filter.To.IsZero() {
+		conds = append(conds, "created_at < "+arg(filter.To))
+	}
+	if len(filter.AfterID) > 0 {
+		after, err := strconv.ParseInt(filter.AfterID, 10, 64)
+		if err != nil {
+			return nil, board.InvalidArgument(board.FieldViolation{Field: "after_id", Description: "not an audit id"})
+		}
+		conds = append(conds, "audit_id < "+arg(after))
+	}
+
+	var where string
+	if len(conds) > 0 {
+		where = "WHERE " + strings.Join(conds, " AND ")
+	}
+
+	rows, err := s.pool.Query(ctx, fmt.Sprintf(`
+		SELECT audit_id, board_id, workspace_id, actor_id, action, request_id, before, after, created_at
+		FROM board_audit %s
+		ORDER BY audit_id DESC
+		LIMIT NULLIF(%s, 0)`, where, arg(int64(size))), args...)
+	if err != nil {
+		return nil, fmt.Errorf(ErrMsgQuery, err)
+	}
+	defer rows.Close()
+
+	var data []board.AuditEntry
+	for rows.Next() {
+		var entry board.AuditEntry
+		var id int64
+		var before, after []byte
+		err = rows.Scan(&id, &entry.BoardID, &entry.WorkspaceID, &entry.ActorID, &entry.Action, &entry.RequestID,
+			&before, &after, &entry.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf(ErrMsgQuery, err)
+		}
+		if err = json.Unmarshal(before, &entry.Before); err != nil {
+			return nil, err
+		}
+		if err = json.Unmarshal(after, &entry.After); err != nil {
+			return nil, err
+		}
+		entry.AuditID = strconv.FormatInt(id, 10)
+		data = append(data, entry)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf(ErrMsgQuery, err)
+	}
+
+	return data, nil
+}
+
+func (s *auditStorage) Purge(ctx context.Context, boardIDs ...string) error {
+	if len(boardIDs) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.opts.OpTimeout("audit"))
+	defer cancel()
+
+	tag, err := s.pool.Exec(ctx, `DELETE FROM board_audit WHERE board_id = ANY($1)`, boardIDs)
+	if err != nil {
+		return fmt.Errorf(ErrMsgQuery, err)
+	}
+
+	s.log.Debug().Int64("count", tag.RowsAffected()).Msg("audit entries purged")
+
+	return nil
+}
@@ -0,0 +1,21 @@
+CREATE TABLE boards (
+    board_id   TEXT PRIMARY KEY,
+    owner_id   TEXT NOT NULL DEFAULT '',
+    name       TEXT NOT NULL DEFAULT '',
+    metadata   TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL,
+    version    BIGINT NOT NULL DEFAULT 1
+);
+
+CREATE INDEX boards_created_at_idx ON boards (created_at DESC);
+CREATE INDEX boards_owner_id_created_at_idx ON boards (owner_id, created_at DESC);
+
+CREATE TABLE board_members (
+    board_id  TEXT NOT NULL REFERENCES boards (board_id) ON DELETE CASCADE,
+    member_id TEXT NOT NULL,
+    roles     TEXT[] NOT NULL DEFAULT '{}',
+    position  BIGSERIAL,
+    PRIMARY KEY (board_id, member_id)
+);
+
+CREATE INDEX board_members_member_id_idx ON board_members (member_id, board_id);
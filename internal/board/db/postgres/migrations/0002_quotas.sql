@@ -0,0 +1,5 @@
+CREATE TABLE quotas (
+    owner_id    TEXT PRIMARY KEY,
+    max_boards  BIGINT NOT NULL DEFAULT 0,
+    max_members BIGINT NOT NULL DEFAULT 0
+);
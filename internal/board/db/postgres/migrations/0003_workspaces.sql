@@ -0,0 +1,6 @@
+ALTER TABLE boards ADD COLUMN workspace_id TEXT NOT NULL DEFAULT '';
+
+DROP INDEX boards_owner_id_created_at_idx;
+
+CREATE INDEX boards_workspace_id_created_at_idx ON boards (workspace_id, created_at DESC);
+CREATE INDEX boards_workspace_id_owner_id_created_at_idx ON boards (workspace_id, owner_id, created_at DESC);
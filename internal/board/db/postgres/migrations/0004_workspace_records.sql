@@ -0,0 +1,18 @@
+CREATE TABLE workspaces (
+    workspace_id TEXT PRIMARY KEY,
+    owner_id     TEXT NOT NULL DEFAULT '',
+    name         TEXT NOT NULL DEFAULT '',
+    created_at   TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX workspaces_owner_id_created_at_idx ON workspaces (owner_id, created_at DESC);
+
+CREATE TABLE workspace_members (
+    workspace_id TEXT NOT NULL REFERENCES workspaces (workspace_id) ON DELETE CASCADE,
+    member_id    TEXT NOT NULL,
+    roles        TEXT[] NOT NULL DEFAULT '{}',
+    position     BIGSERIAL,
+    PRIMARY KEY (workspace_id, member_id)
+);
+
+CREATE INDEX workspace_members_member_id_idx ON workspace_members (member_id, workspace_id);
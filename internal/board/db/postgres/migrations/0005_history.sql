@@ -0,0 +1,38 @@
+CREATE TABLE board_audit (
+    audit_id     BIGSERIAL PRIMARY KEY,
+    board_id     TEXT NOT NULL DEFAULT '',
+    workspace_id TEXT NOT NULL DEFAULT '',
+    actor_id     TEXT NOT NULL DEFAULT '',
+    action       TEXT NOT NULL DEFAULT '',
+    request_id   TEXT NOT NULL DEFAULT '',
+    before       JSONB NOT NULL DEFAULT '{}',
+    after        JSONB NOT NULL DEFAULT '{}',
+    created_at   TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX board_audit_board_id_audit_id_idx ON board_audit (board_id, audit_id DESC);
+CREATE INDEX board_audit_workspace_id_audit_id_idx ON board_audit (workspace_id, audit_id DESC);
+CREATE INDEX board_audit_actor_id_audit_id_idx ON board_audit (actor_id, audit_id DESC);
+
+CREATE TABLE board_versions (
+    board_id   TEXT NOT NULL,
+    version    BIGINT NOT NULL,
+    board      JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (board_id, version)
+);
+
+CREATE INDEX board_versions_created_at_idx ON board_versions (created_at);
+
+CREATE TABLE idempotency_keys (
+    id           TEXT PRIMARY KEY,
+    key          TEXT NOT NULL DEFAULT '',
+    method       TEXT NOT NULL DEFAULT '',
+    fingerprint  TEXT NOT NULL DEFAULT '',
+    response     BYTEA,
+    done         BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at   TIMESTAMPTZ NOT NULL,
+    leased_until TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idempotency_keys_created_at_idx ON idempotency_keys (created_at);
@@ -0,0 +1,108 @@
+package postgres
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/rs/zerolog"
+	"io/fs"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	ErrMsgPool  = "failed to create postgres pool"
+	ErrMsgQuery = "failed to execute query due to error: %w"
+)
+
+// migrationLock is the advisory lock key held while migrating, so that only
+// one replica applies migrations.
+const migrationLock = 7_245_301
+
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+// GetPool connects to the database at dsn and applies pending migrations.
+func GetPool(ctx context.Context, dsn string, log zerolog.Logger) *pgxpool.Pool {
+	pool, err := pgxpool.Connect(ctx, dsn)
+	if err != nil {
+		log.Fatal().Err(err).Msg(ErrMsgPool)
+	}
+
+	if err = Migrate(ctx, pool, log); err != nil {
+		log.Fatal().Err(err).Msg("failed to migrate postgres schema")
+	}
+
+	return pool
+}
+
+// Migrate applies, in order and each in its own transaction, the embedded
+// migrations not recorded in schema_migrations yet. Migration files are named
+// after their version: 0001_boards.sql.
+func Migrate(ctx context.Context, pool *pgxpool.Pool, log zerolog.Logger) error {
+	conn, err := pool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Release()
+
+	if _, err = conn.Exec(ctx, "SELECT pg_advisory_lock($1)", migrationLock); err != nil {
+		return fmt.Errorf(ErrMsgQuery, err)
+	}
+	defer func() {
+		_, _ = conn.Exec(context.Background(), "SELECT pg_advisory_unlock($1)", migrationLock)
+	}()
+
+	if _, err = conn.Exec(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version    BIGINT PRIMARY KEY,
+		name       TEXT NOT NULL,
+		applied_at TIMESTAMPTZ NOT NULL DEFAULT now()
+	)`); err != nil {
+		return fmt.Errorf(ErrMsgQuery, err)
+	}
+
+	files, err := fs.Glob(migrations, "migrations/*.sql")
+	if err != nil {
+		return err
+	}
+	sort.Strings(files)
+
+	for _, file := range files {
+		name := strings.TrimSuffix(strings.TrimPrefix(file, "migrations/"), ".sql")
+		version, err := strconv.ParseInt(strings.SplitN(name, "_", 2)[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid migration name %q: %w", file, err)
+		}
+
+		var applied bool
+		if err = conn.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM schema_migrations WHERE version = $1)", version).Scan(&applied); err != nil {
+			return fmt.Errorf(ErrMsgQuery, err)
+		}
+		if applied {
+			continue
+		}
+
+		script, err := migrations.ReadFile(file)
+		if err != nil {
+			return err
+		}
+
+		err = conn.BeginFunc(ctx, func(tx pgx.Tx) error {
+			if _, err := tx.Exec(ctx, string(script)); err != nil {
+				return err
+			}
+			_, err := tx.Exec(ctx, "INSERT INTO schema_migrations (version, name) VALUES ($1, $2)", version, name)
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("migration %s: %w", name, err)
+		}
+
+		log.Info().Int64("version", version).Str("name", name).Msg("migration applied")
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-funcards/board-service/internal/board"
+	"github.com/go-funcards/board-service/internal/board/db"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/rs/zerolog"
+	"sync/atomic"
+)
+
+var _ board.QuotaStorage = (*quotaStorage)(nil)
+
+// quotaStorage reads limit overrides from the quotas table. Its rows are also
+// what concurrent board creations of an owner are serialized on.
+type quotaStorage struct {
+	pool     *pgxpool.Pool
+	defaults atomic.Value
+	opts     db.Options
+	log      zerolog.Logger
+}
+
+func NewQuotaStorage(pool *pgxpool.Pool, defaults board.Limits, opts db.Options, log zerolog.Logger) *quotaStorage {
+	s := &quotaStorage{
+		pool: pool,
+		opts: opts,
+		log:  log.With().Str("storage", "postgres").Str("table", "quotas").Logger(),
+	}
+	s.SetDefaults(defaults)
+	return s
+}
+
+func (s *quotaStorage) SetDefaults(defaults board.Limits) {
+	s.defaults.Store(defaults)
+}
+
+func (s *quotaStorage) Limits(ctx context.Context, ownerID string) (board.Limits, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.opts.OpTimeout("quota"))
+	defer cancel()
+
+	var limits board.Limits
+	err := s.pool.BeginFunc(ctx, func(tx pgx.Tx) (err error) {
+		limits, err = s.limits(ctx, tx, ownerID, false)
+		return err
+	})
+	return limits, err
+}
+
+// limits returns the limits of the owner, locking its quota row when lock is set.
+func (s *quotaStorage) limits(ctx context.Context, tx pgx.Tx, ownerID string, lock bool) (board.Limits, error) {
+	defaults := s.defaults.Load().(board.Limits)
+
+	query := "SELECT max_boards, max_members FROM quotas WHERE owner_id = $1"
+	if lock {
+		if _, err := tx.Exec(ctx, "INSERT INTO quotas (owner_id) VALUES ($1) ON CONFLICT DO NOTHING", ownerID); err != nil {
+			return defaults, fmt.Errorf(ErrMsgQuery, err)
+		}
+		query += " FOR UPDATE"
+	}
+
+	var override board.Limits
+	err := tx.QueryRow(ctx, query, ownerID).Scan(&override.MaxBoards, &override.MaxMembers)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return defaults, nil
+	}
+	if err != nil {
+		return defaults, fmt.Errorf(ErrMsgQuery, err)
+	}
+	return defaults.Override(override), nil
+}
+
+func (s *quotaStorage) Usage(ctx context.Context, ownerID string) (board.Usage, error) {
+	limits, err := s.Limits(ctx, ownerID)
+	if err != nil {
+		return board.Usage{}, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.opts.OpTimeout("quota"))
+	defer cancel()
+
+	// with a tenant, only the boards in its workspaces are counted
+	var workspaceIDs []string
+	if t, ok := board.TenantFromContext(ctx); ok {
+		workspaceIDs = append([]string{}, t.WorkspaceIDs...)
+	}
+
+	usage := board.Usage{OwnerID: ownerID, Limits: limits}
+	err = s.pool.QueryRow(ctx, `
+		SELECT count(*), COALESCE(sum(m.n), 0)::bigint, COALESCE(max(m.n), 0)
+		FROM boards b
+		LEFT JOIN LATERAL (SELECT count(*) AS n FROM board_members WHERE board_id = b.board_id) m ON true
+		WHERE b.owner_id = $1 AND ($2::text[] IS NULL OR b.workspace_id = ANY($2))`, ownerID, workspaceIDs,
+	).Scan(&usage.Boards, &usage.Members, &usage.LargestBoardMembers)
+	if err != nil {
+		return board.Usage{}, fmt.Errorf(ErrMsgQuery, err)
+	}
+	return usage, nil
+}
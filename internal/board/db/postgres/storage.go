@@ -0,0 +1,646 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-funcards/board-service/internal/board"
+	"github.com/go-funcards/board-service/internal/board/db"
+	"github.com/go-funcards/board-service/internal/tracing"
+	"github.com/go-funcards/slice"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/rs/zerolog"
+	"strings"
+)
+
+var _ board.Storage = (*storage)(nil)
+
+type storage struct {
+	pool   *pgxpool.Pool
+	quotas *quotaStorage
+	opts   db.Options
+	log    zerolog.Logger
+}
+
+func NewStorage(pool *pgxpool.Pool, quotas *quotaStorage, opts db.Options, log zerolog.Logger) *storage {
+	return &storage{
+		pool:   pool,
+		quotas: quotas,
+		opts:   opts,
+		log:    log.With().Str("storage", "postgres").Str("table", "boards").Logger(),
+	}
+}
+
+func (s *storage) Save(ctx context.Context, model board.Board) error {
+	ctx, cancel := context.WithTimeout(ctx, s.opts.OpTimeout("save"))
+	defer cancel()
+
+	tracing.Logger(ctx, s.log).Info().Str("board_id", model.BoardID).Msg("board save")
+
+	var change board.Change
+	err := transact(ctx, s.pool, s.opts.Retry, false, func(tx pgx.Tx) (err error) {
+		change, err = s.save(ctx, tx, model)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	board.RecordChange(ctx, change.Before, change.After)
+
+	tracing.Logger(ctx, s.log).Info().Str("board_id", model.BoardID).Msg("board saved")
+
+	return nil
+}
+
+// SaveMany saves models in order within one transaction. A failing board is
+// rolled back alone and the others are written, like an unordered bulk write.
+func (s *storage) SaveMany(ctx context.Context, models []board.Board) ([]error, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.opts.OpTimeout("save_many"))
+	defer cancel()
+
+	tracing.Logger(ctx, s.log).Info().Int("count", len(models)).Msg("boards save")
+
+	var errs []error
+	var changes []board.Change
+	err := transact(ctx, s.pool, s.opts.Retry, false, func(tx pgx.Tx) error {
+		errs, changes = make([]error, len(models)), nil
+		for i, model := range models {
+			var change board.Change
+			err := tx.BeginFunc(ctx, func(tx pgx.Tx) (err error) {
+				change, err = s.save(ctx, tx, model)
+				return err
+			})
+			if err == nil {
+				changes = append(changes, change)
+				continue
+			}
+			if !itemError(err) {
+				return err
+			}
+			errs[i] = err
+		}
+		return nil
+	})
+	if err != nil {
+		return errs, err
+	}
+
+	for _, change := range changes {
+		board.RecordChange(ctx, change.Before, change.After)
+	}
+
+	return errs, nil
+}
+
+// save upserts the board the way the mongodb storage does: empty fields are
+// left untouched, the workspace, owner and creation time are only set on
+// insert, deleted members are removed before the others are added or
+// replaced. With model.Replace the owner, name, metadata and members are
+// replaced instead. When a board is added or changes owner, the board limit
+// is checked before writing it, with the quota row of the owner locked like
+// the mongodb storage reserves its slot; the member limit is checked after
+// the members are written and fails the transaction. It returns the board
+// as it was and is, read in tx, for the change to be recorded once committed.
+// An existing board outside the tenant of ctx is not found.
+func (s *storage) save(ctx context.Context, tx pgx.Tx, model board.Board) (board.Change, error) {
+	current, err := load(ctx, tx, model.BoardID)
+	exists := err == nil
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return board.Change{}, err
+	}
+	if exists && !board.Allowed(ctx, current.WorkspaceID) {
+		return board.Change{}, board.NotFound(board.ResourceBoard, model.BoardID, nil)
+	}
+	previous := current.OwnerID
+
+	if !exists {
+		if err = lockWorkspace(ctx, tx, model.WorkspaceID); err != nil {
+			return board.Change{}, err
+		}
+	}
+
+	ownerID := previous
+	if !exists || model.Replace {
+		ownerID = model.OwnerID
+	}
+	transferred := exists && model.Replace && previous != ownerID
+	added := (!exists || transferred) && len(ownerID) > 0
+
+	limits, err := s.quotas.limits(ctx, tx, ownerID, added)
+	if err != nil {
+		return board.Change{}, err
+	}
+
+	if added && limits.MaxBoards > 0 {
+		var n uint64
+		if err = tx.QueryRow(ctx, "SELECT count(*) FROM boards WHERE owner_id = $1", ownerID).Scan(&n); err != nil {
+			return board.Change{}, fmt.Errorf(ErrMsgQuery, err)
+		}
+		if n >= limits.MaxBoards {
+			return board.Change{}, board.BoardQuotaExceeded(ownerID, limits.MaxBoards)
+		}
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO boards (board_id, workspace_id, owner_id, name, metadata, created_at, version)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (board_id) DO UPDATE SET
+			owner_id = CASE WHEN $8 THEN EXCLUDED.owner_id ELSE boards.owner_id END,
+			name     = CASE WHEN $8 THEN EXCLUDED.name ELSE COALESCE(NULLIF(EXCLUDED.name, ''), boards.name) END,
+			metadata = CASE WHEN $8 THEN EXCLUDED.metadata ELSE COALESCE(NULLIF(EXCLUDED.metadata, ''), boards.metadata) END,
+			version  = boards.version + 1`,
+		model.BoardID, model.WorkspaceID, model.OwnerID, model.Name, model.Metadata, model.CreatedAt, model.Version+1,
+		model.Replace,
+	)
+	if err != nil {
+		return board.Change{}, fmt.Errorf(ErrMsgQuery, err)
+	}
+
+	if model.Replace {
+		if _, err = tx.Exec(ctx, "DELETE FROM board_members WHERE board_id = $1", model.BoardID); err != nil {
+			return board.Change{}, fmt.Errorf(ErrMsgQuery, err)
+		}
+	} else if len(model.Members) > 0 {
+		ids := make([]string, 0, len(model.Members))
+		for _, m := range model.Members {
+			ids = append(ids, m.MemberID)
+		}
+		if _, err = tx.Exec(ctx, "DELETE FROM board_members WHERE board_id = $1 AND member_id = ANY($2)", model.BoardID, ids); err != nil {
+			return board.Change{}, fmt.Errorf(ErrMsgQuery, err)
+		}
+	}
+
+	written := 0
+	for _, m := range model.Members {
+		if m.Delete {
+			continue
+		}
+		written++
+		_, err = tx.Exec(ctx, `
+			INSERT INTO board_members (board_id, member_id, roles) VALUES ($1, $2, $3)
+			ON CONFLICT (board_id, member_id) DO UPDATE SET roles = EXCLUDED.roles`,
+			model.BoardID, m.MemberID, m.Roles,
+		)
+		if err != nil {
+			return board.Change{}, fmt.Errorf(ErrMsgQuery, err)
+		}
+	}
+
+	if (written > 0 || transferred) && limits.MaxMembers > 0 {
+		var n uint64
+		if err = tx.QueryRow(ctx, "SELECT count(*) FROM board_members WHERE board_id = $1", model.BoardID).Scan(&n); err != nil {
+			return board.Change{}, fmt.Errorf(ErrMsgQuery, err)
+		}
+		if n > limits.MaxMembers {
+			return board.Change{}, board.MemberQuotaExceeded(model.BoardID, limits.MaxMembers)
+		}
+	}
+
+	return changed(ctx, tx, current, exists, model.BoardID)
+}
+
+// Restore inserts models as they are within one transaction, skipping the
+// boards that already exist. Limits are not checked, the boards were
+// within them when backed up.
+func (s *storage) Restore(ctx context.Context, models []board.Board) ([]error, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.opts.OpTimeout("save_many"))
+	defer cancel()
+
+	tracing.Logger(ctx, s.log).Info().Int("count", len(models)).Msg("boards restore")
+
+	var errs []error
+	err := transact(ctx, s.pool, s.opts.Retry, false, func(tx pgx.Tx) error {
+		errs = make([]error, len(models))
+		for i, model := range models {
+			tag, err := tx.Exec(ctx, `
+				INSERT INTO boards (board_id, workspace_id, owner_id, name, metadata, created_at, version)
+				VALUES ($1, $2, $3, $4, $5, $6, $7)
+				ON CONFLICT (board_id) DO NOTHING`,
+				model.BoardID, model.WorkspaceID, model.OwnerID, model.Name, model.Metadata, model.CreatedAt, model.Version,
+			)
+			if err != nil {
+				return fmt.Errorf(ErrMsgQuery, err)
+			}
+			if tag.RowsAffected() == 0 {
+				errs[i] = board.AlreadyExists(board.ResourceBoard, model.BoardID, nil)
+				continue
+			}
+
+			for _, m := range model.Members {
+				_, err = tx.Exec(ctx, "INSERT INTO board_members (board_id, member_id, roles) VALUES ($1, $2, $3)",
+					model.BoardID, m.MemberID, m.Roles,
+				)
+				if err != nil {
+					return fmt.Errorf(ErrMsgQuery, err)
+				}
+			}
+		}
+		return nil
+	})
+	return errs, err
+}
+
+func (s *storage) Delete(ctx context.Context, id string) error {
+	ctx, cancel := context.WithTimeout(ctx, s.opts.OpTimeout("delete"))
+	defer cancel()
+
+	tracing.Logger(ctx, s.log).Debug().Str("board_id", id).Msg("board delete")
+
+	var deleted board.Board
+	err := transact(ctx, s.pool, s.opts.Retry, false, func(tx pgx.Tx) (err error) {
+		deleted, err = remove(ctx, tx, id)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	board.RecordChange(ctx, &deleted, nil)
+
+	tracing.Logger(ctx, s.log).Debug().Str("board_id", id).Msg("board deleted")
+
+	return nil
+}
+
+func (s *storage) DeleteMany(ctx context.Context, ids []string) ([]error, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.opts.OpTimeout("delete_many"))
+	defer cancel()
+
+	tracing.Logger(ctx, s.log).Debug().Strs("board_ids", ids).Msg("boards delete")
+
+	var errs []error
+	var deleted []board.Board
+	err := transact(ctx, s.pool, s.opts.Retry, false, func(tx pgx.Tx) error {
+		errs, deleted = make([]error, len(ids)), nil
+		for i, id := range ids {
+			b, err := remove(ctx, tx, id)
+			if err == nil {
+				deleted = append(deleted, b)
+				continue
+			}
+			if !itemError(err) {
+				return err
+			}
+			errs[i] = err
+		}
+		return nil
+	})
+	if err != nil {
+		return errs, err
+	}
+
+	for i := range deleted {
+		board.RecordChange(ctx, &deleted[i], nil)
+	}
+
+	return errs, nil
+}
+
+// Transfer moves the board with the quota row of the new owner locked, so
+// the board limit holds against concurrent creations.
+func (s *storage) Transfer(ctx context.Context, id, ownerID string) error {
+	ctx, cancel := context.WithTimeout(ctx, s.opts.OpTimeout("save"))
+	defer cancel()
+
+	tracing.Logger(ctx, s.log).Info().Str("board_id", id).Str("owner_id", ownerID).Msg("board transfer")
+
+	var change board.Change
+	err := transact(ctx, s.pool, s.opts.Retry, false, func(tx pgx.Tx) error {
+		current, err := lookup(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		change = board.Change{}
+		if current.OwnerID == ownerID {
+			return nil
+		}
+
+		limits, err := s.quotas.limits(ctx, tx, ownerID, true)
+		if err != nil {
+			return err
+		}
+
+		var boards, members uint64
+		err = tx.QueryRow(ctx, `
+			SELECT
+				(SELECT count(*) FROM boards WHERE owner_id = $1),
+				(SELECT count(*) FROM board_members WHERE board_id = $2)`,
+			ownerID, id,
+		).Scan(&boards, &members)
+		if err != nil {
+			return fmt.Errorf(ErrMsgQuery, err)
+		}
+		if limits.MaxBoards > 0 && boards >= limits.MaxBoards {
+			return board.BoardQuotaExceeded(ownerID, limits.MaxBoards)
+		}
+		if limits.MaxMembers > 0 && members > limits.MaxMembers {
+			return board.MemberQuotaExceeded(id, limits.MaxMembers)
+		}
+
+		if _, err = tx.Exec(ctx, "UPDATE boards SET owner_id = $2, version = version + 1 WHERE board_id = $1", id, ownerID); err != nil {
+			return fmt.Errorf(ErrMsgQuery, err)
+		}
+		change, err = changed(ctx, tx, current, true, id)
+		return err
+	})
+	if err == nil && change.After != nil {
+		board.RecordChange(ctx, change.Before, change.After)
+	}
+	return err
+}
+
+func (s *storage) Move(ctx context.Context, id, workspaceID string) error {
+	ctx, cancel := context.WithTimeout(ctx, s.opts.OpTimeout("save"))
+	defer cancel()
+
+	tracing.Logger(ctx, s.log).Info().Str("board_id", id).Str("workspace_id", workspaceID).Msg("board move")
+
+	var change board.Change
+	err := transact(ctx, s.pool, s.opts.Retry, false, func(tx pgx.Tx) error {
+		current, err := lookup(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		change = board.Change{}
+		if current.WorkspaceID == workspaceID {
+			return nil
+		}
+		if !board.Allowed(ctx, workspaceID) {
+			return board.PermissionDenied(board.ResourceWorkspace, workspaceID, "workspace is not available to the caller")
+		}
+		if err = lockWorkspace(ctx, tx, workspaceID); err != nil {
+			return err
+		}
+
+		if _, err = tx.Exec(ctx, "UPDATE boards SET workspace_id = $2, version = version + 1 WHERE board_id = $1", id, workspaceID); err != nil {
+			return fmt.Errorf(ErrMsgQuery, err)
+		}
+		change, err = changed(ctx, tx, current, true, id)
+		return err
+	})
+	if err == nil && change.After != nil {
+		board.RecordChange(ctx, change.Before, change.After)
+	}
+	return err
+}
+
+// load reads the board with its members in tx, locking its row until tx
+// ends. It returns pgx.ErrNoRows when there is no such board.
+func load(ctx context.Context, tx pgx.Tx, id string) (board.Board, error) {
+	var b board.Board
+	err := tx.QueryRow(ctx, `
+		SELECT board_id, workspace_id, owner_id, name, metadata, created_at, version
+		FROM boards WHERE board_id = $1 FOR UPDATE`, id,
+	).Scan(&b.BoardID, &b.WorkspaceID, &b.OwnerID, &b.Name, &b.Metadata, &b.CreatedAt, &b.Version)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return b, err
+	}
+	if err != nil {
+		return b, fmt.Errorf(ErrMsgQuery, err)
+	}
+
+	rows, err := tx.Query(ctx, "SELECT member_id, roles FROM board_members WHERE board_id = $1 ORDER BY position", id)
+	if err != nil {
+		return b, fmt.Errorf(ErrMsgQuery, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var m board.Member
+		if err = rows.Scan(&m.MemberID, &m.Roles); err != nil {
+			return b, fmt.Errorf(ErrMsgQuery, err)
+		}
+		b.Members = append(b.Members, m)
+	}
+	if err = rows.Err(); err != nil {
+		return b, fmt.Errorf(ErrMsgQuery, err)
+	}
+	return b, nil
+}
+
+// changed reads the board written in tx back and returns the change made to
+// current, which existed before the write or not.
+func changed(ctx context.Context, tx pgx.Tx, current board.Board, exists bool, id string) (board.Change, error) {
+	next, err := load(ctx, tx, id)
+	if err != nil {
+		return board.Change{}, err
+	}
+	change := board.Change{After: &next}
+	if exists {
+		change.Before = &current
+	}
+	return change, nil
+}
+
+// lookup loads the board to be written with ctx in tx, which is not found
+// outside of the tenant of ctx.
+func lookup(ctx context.Context, tx pgx.Tx, id string) (board.Board, error) {
+	b, err := load(ctx, tx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return b, board.NotFound(board.ResourceBoard, id, err)
+	}
+	if err != nil {
+		return b, err
+	}
+	if !board.Allowed(ctx, b.WorkspaceID) {
+		return b, board.NotFound(board.ResourceBoard, id, nil)
+	}
+	return b, nil
+}
+
+// remove deletes the board in tx, its members with it, and returns it as it
+// was.
+func remove(ctx context.Context, tx pgx.Tx, id string) (board.Board, error) {
+	b, err := lookup(ctx, tx, id)
+	if err != nil {
+		return b, err
+	}
+	if _, err = tx.Exec(ctx, "DELETE FROM boards WHERE board_id = $1", id); err != nil {
+		return b, fmt.Errorf(ErrMsgQuery, err)
+	}
+	return b, nil
+}
+
+// transact runs fn in a transaction, which policy runs again when it was
+// rolled back as a whole by a serialization failure or a deadlock, or when
+// it failed before reaching the server.
+func transact(ctx context.Context, pool *pgxpool.Pool, policy db.RetryPolicy, idempotent bool, fn func(tx pgx.Tx) error) error {
+	return policy.Do(ctx, idempotent, func(ctx context.Context) error {
+		return pool.BeginFunc(ctx, fn)
+	})
+}
+
+// lockWorkspace holds a key share lock on the row of the workspace a board
+// is added to, if it has one, until tx ends. It keeps the workspace from
+// being deleted without the board being counted, and fails with NotFound
+// when there is no such workspace.
+func lockWorkspace(ctx context.Context, tx pgx.Tx, workspaceID string) error {
+	if len(workspaceID) == 0 {
+		return nil
+	}
+	tag, err := tx.Exec(ctx, "SELECT 1 FROM workspaces WHERE workspace_id = $1 FOR KEY SHARE", workspaceID)
+	if err != nil {
+		return fmt.Errorf(ErrMsgQuery, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return board.NotFound(board.ResourceWorkspace, workspaceID, pgx.ErrNoRows)
+	}
+	return nil
+}
+
+func (s *storage) Find(ctx context.Context, filter board.Filter, index uint64, size uint32) (data []board.Board, err error) {
+	ctx, cancel := context.WithTimeout(ctx, s.opts.OpTimeout("find"))
+	defer cancel()
+
+	err = s.opts.Retry.Do(ctx, true, func(ctx context.Context) (err error) {
+		data, err = s.find(ctx, filter, index, size)
+		return err
+	})
+	return data, err
+}
+
+func (s *storage) find(ctx context.Context, filter board.Filter, index uint64, size uint32) ([]board.Board, error) {
+	where, args := s.build(filter)
+	args = append(args, int64(size), int64(index))
+	rows, err := s.pool.Query(ctx, fmt.Sprintf(`
+		SELECT board_id, workspace_id, owner_id, name, metadata, created_at, version
+		FROM boards %s
+		ORDER BY created_at DESC
+		LIMIT $%d OFFSET $%d`, where, len(args)-1, len(args)), args...)
+	if err != nil {
+		return nil, fmt.Errorf(ErrMsgQuery, err)
+	}
+	defer rows.Close()
+
+	var data []board.Board
+	positions := make(map[string]int)
+	for rows.Next() {
+		var b board.Board
+		if err = rows.Scan(&b.BoardID, &b.WorkspaceID, &b.OwnerID, &b.Name, &b.Metadata, &b.CreatedAt, &b.Version); err != nil {
+			return nil, fmt.Errorf(ErrMsgQuery, err)
+		}
+		positions[b.BoardID] = len(data)
+		data = append(data, b)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf(ErrMsgQuery, err)
+	}
+
+	if len(data) == 0 || !selected(filter.Fields, "members") {
+		return data, nil
+	}
+
+	ids := make([]string, 0, len(data))
+	for _, b := range data {
+		ids = append(ids, b.BoardID)
+	}
+	rows, err = s.pool.Query(ctx, `
+		SELECT board_id, member_id, roles FROM board_members
+		WHERE board_id = ANY($1)
+		ORDER BY position`, ids)
+	if err != nil {
+		return nil, fmt.Errorf(ErrMsgQuery, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var boardID string
+		var m board.Member
+		if err = rows.Scan(&boardID, &m.MemberID, &m.Roles); err != nil {
+			return nil, fmt.Errorf(ErrMsgQuery, err)
+		}
+		i := positions[boardID]
+		data[i].Members = append(data[i].Members, m)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf(ErrMsgQuery, err)
+	}
+
+	return data, nil
+}
+
+func (s *storage) Count(ctx context.Context, filter board.Filter) (uint64, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.opts.OpTimeout("count"))
+	defer cancel()
+
+	where, args := s.build(filter)
+
+	var total uint64
+	err := s.opts.Retry.Do(ctx, true, func(ctx context.Context) error {
+		return s.pool.QueryRow(ctx, "SELECT count(*) FROM boards "+where, args...).Scan(&total)
+	})
+	if err != nil {
+		return 0, fmt.Errorf(ErrMsgQuery, err)
+	}
+	return total, nil
+}
+
+func (s *storage) Stats(ctx context.Context) (board.Stats, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.opts.OpTimeout("count"))
+	defer cancel()
+
+	var stats board.Stats
+	err := s.opts.Retry.Do(ctx, true, func(ctx context.Context) error {
+		return s.pool.QueryRow(ctx, "SELECT (SELECT count(*) FROM boards), (SELECT count(*) FROM board_members)").
+			Scan(&stats.Boards, &stats.Members)
+	})
+	if err != nil {
+		return stats, fmt.Errorf(ErrMsgQuery, err)
+	}
+	return stats, nil
+}
+
+// build returns the WHERE clause matching filter, with boards of the owners OR
+// the members OR the member workspaces when several are given, and its
+// arguments. Boards without a workspace have an empty workspace_id.
+func (s *storage) build(filter board.Filter) (string, []any) {
+	var conds []string
+	var args []any
+	arg := func(v any) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+	members := func() string {
+		return fmt.Sprintf("board_id IN (SELECT board_id FROM board_members WHERE member_id = ANY(%s))", arg(filter.MemberIDs))
+	}
+
+	if len(filter.BoardIDs) > 0 {
+		conds = append(conds, fmt.Sprintf("board_id = ANY(%s)", arg(filter.BoardIDs)))
+	}
+	if len(filter.WorkspaceIDs) > 0 {
+		conds = append(conds, fmt.Sprintf("workspace_id = ANY(%s)", arg(filter.WorkspaceIDs)))
+	}
+	var or []string
+	if len(filter.OwnerIDs) > 0 {
+		or = append(or, fmt.Sprintf("owner_id = ANY(%s)", arg(filter.OwnerIDs)))
+	}
+	if len(filter.MemberIDs) > 0 {
+		or = append(or, members())
+	}
+	if len(filter.MemberWorkspaceIDs) > 0 {
+		or = append(or, fmt.Sprintf("workspace_id = ANY(%s)", arg(filter.MemberWorkspaceIDs)))
+	}
+	if len(or) > 1 {
+		conds = append(conds, "("+strings.Join(or, " OR ")+")")
+	} else if len(or) == 1 {
+		conds = append(conds, or[0])
+	}
+
+	if len(conds) == 0 {
+		return "", nil
+	}
+	return "WHERE " + strings.Join(conds, " AND "), args
+}
+
+func selected(fields []string, field string) bool {
+	return len(fields) == 0 || slice.Contains(fields, field)
+}
+
+// itemError reports whether err is caused by the board being saved rather than
+// by the connection or the transaction.
+func itemError(err error) bool {
+	var e *board.Error
+	var pe *pgconn.PgError
+	return errors.As(err, &e) || errors.As(err, &pe)
+}
@@ -0,0 +1,74 @@
+package postgres
+
+import (
+	"context"
+	"github.com/go-funcards/board-service/internal/board"
+	"github.com/go-funcards/board-service/internal/board/db"
+	"github.com/go-funcards/board-service/internal/board/storagetest"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/rs/zerolog"
+	"os"
+	"testing"
+)
+
+// TestStorage runs against the database at POSTGRES_DSN, which it migrates
+// and whose tables it empties before every test.
+func TestStorage(t *testing.T) {
+	pool := connect(t)
+
+	storagetest.Run(t, func(t *testing.T) storagetest.Storages {
+		return open(t, pool, board.Limits{})
+	})
+}
+
+func TestStorageLimits(t *testing.T) {
+	pool := connect(t)
+
+	storagetest.RunLimits(t, func(t *testing.T, limits board.Limits) storagetest.Storages {
+		return open(t, pool, limits)
+	})
+}
+
+func TestWorkspaceStorage(t *testing.T) {
+	pool := connect(t)
+
+	storagetest.RunWorkspaces(t, func(t *testing.T) storagetest.Storages {
+		return open(t, pool, board.Limits{})
+	})
+}
+
+func open(t *testing.T, pool *pgxpool.Pool, limits board.Limits) storagetest.Storages {
+	truncate(t, pool)
+
+	log := zerolog.Nop()
+	quotas := NewQuotaStorage(pool, limits, db.Options{}, log)
+	return storagetest.Storages{
+		Boards:     NewStorage(pool, quotas, db.Options{}, log),
+		Workspaces: NewWorkspaceStorage(pool, db.Options{}, log),
+		Quotas:     quotas,
+	}
+}
+
+func connect(t *testing.T) *pgxpool.Pool {
+	dsn := os.Getenv("POSTGRES_DSN")
+	if len(dsn) == 0 {
+		t.Skip("POSTGRES_DSN not set")
+	}
+
+	pool, err := pgxpool.Connect(context.Background(), dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(pool.Close)
+
+	if err = Migrate(context.Background(), pool, zerolog.Nop()); err != nil {
+		t.Fatal(err)
+	}
+	return pool
+}
+
+func truncate(t *testing.T, pool *pgxpool.Pool) {
+	if _, err := pool.Exec(context.Background(), "TRUNCATE boards, quotas, workspaces CASCADE"); err != nil {
+		t.Fatal(err)
+	}
+}
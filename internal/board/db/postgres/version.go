@@ -0,0 +1,185 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/go-funcards/board-service/internal/board"
+	"github.com/go-funcards/board-service/internal/board/db"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/rs/zerolog"
+	"time"
+)
+
+var _ board.VersionStorage = (*versionStorage)(nil)
+
+type versionStorage struct {
+	pool     *pgxpool.Pool
+	maxCount uint64
+	maxAge   time.Duration
+	opts     db.Options
+	log      zerolog.Logger
+}
+
+// NewVersionStorage keeps at most maxCount versions per board (0 keeps all)
+// and drops versions older than maxAge (0 never drops them) when a board gets
+// a new one.
+func NewVersionStorage(pool *pgxpool.Pool, maxCount uint64, maxAge time.Duration, opts db.Options, log zerolog.Logger) *versionStorage {
+	return &versionStorage{
+		pool:     pool,
+		maxCount: maxCount,
+		maxAge:   maxAge,
+		opts:     opts,
+		log:      log.With().Str("storage", "postgres").Str("table", "board_versions").Logger(),
+	}
+}
+
+func (s *versionStorage) Append(ctx context.Context, versions ...board.BoardVersion) error {
+	if len(versions) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.opts.OpTimeout("versions"))
+	defer cancel()
+
+	// a recorded version is never replaced, the others are still stored
+	var conflict error
+	err := s.pool.BeginFunc(ctx, func(tx pgx.Tx) error {
+		for _, v := range versions {
+			data, err := json.Marshal(v.Board)
+			if err != nil {
+				return err
+			}
+			tag, err := tx.Exec(ctx, `
+				INSERT INTO board_versions (board_id, version, board, created_at) VALUES ($1, $2, $3, $4)
+				ON CONFLICT (board_id, version) DO NOTHING`,
+				v.BoardID, int64(v.Version), string(data), v.CreatedAt,
+			)
+			if err != nil {
+				return fmt.Errorf(ErrMsgQuery, err)
+			}
+			if tag.RowsAffected() == 0 {
+				if conflict == nil {
+					conflict = board.AlreadyExists(board.ResourceBoardVersion, fmt.Sprintf("%s@%d", v.BoardID, v.Version), nil)
+				}
+				continue
+			}
+			if err = s.prune(ctx, tx, v); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	s.log.Debug().Int("count", len(versions)).Msg("board versions appended")
+
+	return conflict
+}
+
+// prune deletes the versions of the board of v beyond the retention limits.
+func (s *versionStorage) prune(ctx context.Context, tx pgx.Tx, v board.BoardVersion) error {
+	if s.maxCount > 0 && v.Version > s.maxCount {
+		if _, err := tx.Exec(ctx, "DELETE FROM board_versions WHERE board_id = $1 AND version <= $2",
+			v.BoardID, int64(v.Version-s.maxCount)); err != nil {
+			return fmt.Errorf(ErrMsgQuery, err)
+		}
+	}
+	if s.maxAge > 0 {
+		if _, err := tx.Exec(ctx, "DELETE FROM board_versions WHERE board_id = $1 AND created_at < $2",
+			v.BoardID, time.Now().Add(-s.maxAge)); err != nil {
+			return fmt.Errorf(ErrMsgQuery, err)
+		}
+	}
+	return nil
+}
+
+func (s *versionStorage) FindOne(ctx context.Context, boardID string, version uint64) (board.BoardVersion, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.opts.OpTimeout("versions"))
+	defer cancel()
+
+	v, err := s.scan(s.pool.QueryRow(ctx, `
+		SELECT board_id, version, board, created_at FROM board_versions
+		WHERE board_id = $1 AND version = $2`, boardID, int64(version)))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return v, board.NotFound(board.ResourceBoardVersion, fmt.Sprintf("%s@%d", boardID, version), err)
+	}
+	return v, err
+}
+
+// Find pages through the versions of the board, newest first.
+func (s *versionStorage) Find(ctx context.Context, boardID string, index uint64, size uint32) ([]board.BoardVersion, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.opts.OpTimeout("versions"))
+	defer cancel()
+
+	rows, err := s.pool.Query(ctx, `
+		SELECT board_id, version, board, created_at FROM board_versions
+		WHERE board_id = $1
+		ORDER BY version DESC
+		LIMIT NULLIF($2, 0) OFFSET $3`, boardID, int64(size), int64(index))
+	if err != nil {
+		return nil, fmt.Errorf(ErrMsgQuery, err)
+	}
+	defer rows.Close()
+
+	var data []board.BoardVersion
+	for rows.Next() {
+		v, err := s.scan(rows)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, v)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf(ErrMsgQuery, err)
+	}
+
+	return data, nil
+}
+
+func (s *versionStorage) Count(ctx context.Context, boardID string) (uint64, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.opts.OpTimeout("versions"))
+	defer cancel()
+
+	var total uint64
+	if err := s.pool.QueryRow(ctx, "SELECT count(*) FROM board_versions WHERE board_id = $1", boardID).Scan(&total); err != nil {
+		return 0, fmt.Errorf(ErrMsgQuery, err)
+	}
+	return total, nil
+}
+
+func (s *versionStorage) scan(row pgx.Row) (board.BoardVersion, error) {
+	var v board.BoardVersion
+	var version int64
+	var data []byte
+	if err := row.Scan(&v.BoardID, &version, &data, &v.CreatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return v, err
+		}
+		return v, fmt.Errorf(ErrMsgQuery, err)
+	}
+	v.Version = uint64(version)
+	return v, json.Unmarshal(data, &v.Board)
+}
+
+func (s *versionStorage) Purge(ctx context.Context, boardIDs ...string) error {
+	if len(boardIDs) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.opts.OpTimeout("versions"))
+	defer cancel()
+
+	tag, err := s.pool.Exec(ctx, `DELETE FROM board_versions WHERE board_id = ANY($1)`, boardIDs)
+	if err != nil {
+		return fmt.Errorf(ErrMsgQuery, err)
+	}
+
+	s.log.Debug().Int64("count", tag.RowsAffected()).Msg("board versions purged")
+
+	return nil
+}
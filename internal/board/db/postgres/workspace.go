@@ -0,0 +1,267 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-funcards/board-service/internal/board"
+	"github.com/go-funcards/board-service/internal/board/db"
+	"github.com/go-funcards/board-service/internal/tracing"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/rs/zerolog"
+	"strings"
+)
+
+var _ board.WorkspaceStorage = (*workspaceStorage)(nil)
+
+type workspaceStorage struct {
+	pool *pgxpool.Pool
+	opts db.Options
+	log  zerolog.Logger
+}
+
+func NewWorkspaceStorage(pool *pgxpool.Pool, opts db.Options, log zerolog.Logger) *workspaceStorage {
+	return &workspaceStorage{
+		pool: pool,
+		opts: opts,
+		log:  log.With().Str("storage", "postgres").Str("table", "workspaces").Logger(),
+	}
+}
+
+func (s *workspaceStorage) Create(ctx context.Context, model board.Workspace) error {
+	ctx, cancel := context.WithTimeout(ctx, s.opts.OpTimeout("save"))
+	defer cancel()
+
+	tracing.Logger(ctx, s.log).Info().Str("workspace_id", model.WorkspaceID).Msg("workspace create")
+
+	return transact(ctx, s.pool, s.opts.Retry, false, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(ctx, `
+			INSERT INTO workspaces (workspace_id, owner_id, name, created_at)
+			VALUES ($1, $2, $3, $4)
+			ON CONFLICT (workspace_id) DO NOTHING`,
+			model.WorkspaceID, model.OwnerID, model.Name, model.CreatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf(ErrMsgQuery, err)
+		}
+		if tag.RowsAffected() == 0 {
+			return board.AlreadyExists(board.ResourceWorkspace, model.WorkspaceID, nil)
+		}
+		return s.members(ctx, tx, model)
+	})
+}
+
+// Update merges model into the workspace the way boards are: an empty name
+// is left untouched, the members being changed are removed before the
+// others are added back.
+func (s *workspaceStorage) Update(ctx context.Context, model board.Workspace) error {
+	ctx, cancel := context.WithTimeout(ctx, s.opts.OpTimeout("save"))
+	defer cancel()
+
+	tracing.Logger(ctx, s.log).Info().Str("workspace_id", model.WorkspaceID).Msg("workspace update")
+
+	return transact(ctx, s.pool, s.opts.Retry, false, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(ctx, `
+			UPDATE workspaces SET name = COALESCE(NULLIF($2, ''), name)
+			WHERE workspace_id = $1`,
+			model.WorkspaceID, model.Name,
+		)
+		if err != nil {
+			return fmt.Errorf(ErrMsgQuery, err)
+		}
+		if tag.RowsAffected() == 0 {
+			return board.NotFound(board.ResourceWorkspace, model.WorkspaceID, pgx.ErrNoRows)
+		}
+		return s.members(ctx, tx, model)
+	})
+}
+
+// members removes the members of model being changed and adds the others
+// back with their new roles.
+func (s *workspaceStorage) members(ctx context.Context, tx pgx.Tx, model board.Workspace) error {
+	if len(model.Members) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0, len(model.Members))
+	for _, m := range model.Members {
+		ids = append(ids, m.MemberID)
+	}
+	if _, err := tx.Exec(ctx, "DELETE FROM workspace_members WHERE workspace_id = $1 AND member_id = ANY($2)", model.WorkspaceID, ids); err != nil {
+		return fmt.Errorf(ErrMsgQuery, err)
+	}
+
+	for _, m := range model.Members {
+		if m.Delete {
+			continue
+		}
+		_, err := tx.Exec(ctx, `
+			INSERT INTO workspace_members (workspace_id, member_id, roles) VALUES ($1, $2, $3)
+			ON CONFLICT (workspace_id, member_id) DO UPDATE SET roles = EXCLUDED.roles`,
+			model.WorkspaceID, m.MemberID, m.Roles,
+		)
+		if err != nil {
+			return fmt.Errorf(ErrMsgQuery, err)
+		}
+	}
+	return nil
+}
+
+// Delete locks the workspace row before counting its boards. Board writes
+// placing a board in a workspace hold a key share lock on its row, so they
+// either commit before the count sees them or wait until the row is gone.
+func (s *workspaceStorage) Delete(ctx context.Context, id string) error {
+	ctx, cancel := context.WithTimeout(ctx, s.opts.OpTimeout("delete"))
+	defer cancel()
+
+	err := transact(ctx, s.pool, s.opts.Retry, false, func(tx pgx.Tx) error {
+		var found string
+		err := tx.QueryRow(ctx, "SELECT workspace_id FROM workspaces WHERE workspace_id = $1 FOR UPDATE", id).Scan(&found)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return board.NotFound(board.ResourceWorkspace, id, err)
+		}
+		if err != nil {
+			return fmt.Errorf(ErrMsgQuery, err)
+		}
+
+		// a statement of its own, seeing the boards committed while the lock was awaited
+		var n uint64
+		if err = tx.QueryRow(ctx, "SELECT count(*) FROM boards WHERE workspace_id = $1", id).Scan(&n); err != nil {
+			return fmt.Errorf(ErrMsgQuery, err)
+		}
+		if n > 0 {
+			return board.Conflict(board.ResourceWorkspace, id, fmt.Sprintf("workspace still has %d boards", n))
+		}
+
+		if _, err = tx.Exec(ctx, "DELETE FROM workspaces WHERE workspace_id = $1", id); err != nil {
+			return fmt.Errorf(ErrMsgQuery, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	tracing.Logger(ctx, s.log).Debug().Str("workspace_id", id).Msg("workspace deleted")
+
+	return nil
+}
+
+// Find lists every matching workspace when size is 0, like the other drivers.
+func (s *workspaceStorage) Find(ctx context.Context, filter board.WorkspaceFilter, index uint64, size uint32) (data []board.Workspace, err error) {
+	ctx, cancel := context.WithTimeout(ctx, s.opts.OpTimeout("find"))
+	defer cancel()
+
+	err = s.opts.Retry.Do(ctx, true, func(ctx context.Context) (err error) {
+		data, err = s.find(ctx, filter, index, size)
+		return err
+	})
+	return data, err
+}
+
+func (s *workspaceStorage) find(ctx context.Context, filter board.WorkspaceFilter, index uint64, size uint32) ([]board.Workspace, error) {
+	where, args := s.build(filter)
+	args = append(args, int64(size), int64(index))
+	rows, err := s.pool.Query(ctx, fmt.Sprintf(`
+		SELECT workspace_id, owner_id, name, created_at
+		FROM workspaces %s
+		ORDER BY created_at DESC
+		LIMIT NULLIF($%d, 0) OFFSET $%d`, where, len(args)-1, len(args)), args...)
+	if err != nil {
+		return nil, fmt.Errorf(ErrMsgQuery, err)
+	}
+	defer rows.Close()
+
+	var data []board.Workspace
+	positions := make(map[string]int)
+	for rows.Next() {
+		var w board.Workspace
+		if err = rows.Scan(&w.WorkspaceID, &w.OwnerID, &w.Name, &w.CreatedAt); err != nil {
+			return nil, fmt.Errorf(ErrMsgQuery, err)
+		}
+		positions[w.WorkspaceID] = len(data)
+		data = append(data, w)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf(ErrMsgQuery, err)
+	}
+
+	if len(data) == 0 {
+		return data, nil
+	}
+
+	ids := make([]string, 0, len(data))
+	for _, w := range data {
+		ids = append(ids, w.WorkspaceID)
+	}
+	rows, err = s.pool.Query(ctx, `
+		SELECT workspace_id, member_id, roles FROM workspace_members
+		WHERE workspace_id = ANY($1)
+		ORDER BY position`, ids)
+	if err != nil {
+		return nil, fmt.Errorf(ErrMsgQuery, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var workspaceID string
+		var m board.Member
+		if err = rows.Scan(&workspaceID, &m.MemberID, &m.Roles); err != nil {
+			return nil, fmt.Errorf(ErrMsgQuery, err)
+		}
+		i := positions[workspaceID]
+		data[i].Members = append(data[i].Members, m)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf(ErrMsgQuery, err)
+	}
+
+	return data, nil
+}
+
+func (s *workspaceStorage) Count(ctx context.Context, filter board.WorkspaceFilter) (uint64, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.opts.OpTimeout("count"))
+	defer cancel()
+
+	where, args := s.build(filter)
+
+	var total uint64
+	err := s.opts.Retry.Do(ctx, true, func(ctx context.Context) error {
+		return s.pool.QueryRow(ctx, "SELECT count(*) FROM workspaces "+where, args...).Scan(&total)
+	})
+	if err != nil {
+		return 0, fmt.Errorf(ErrMsgQuery, err)
+	}
+	return total, nil
+}
+
+// build returns the WHERE clause matching filter, with workspaces of the
+// owners OR the members when both are given, and its arguments.
+func (s *workspaceStorage) build(filter board.WorkspaceFilter) (string, []any) {
+	var conds []string
+	var args []any
+	arg := func(v any) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+	members := func() string {
+		return fmt.Sprintf("workspace_id IN (SELECT workspace_id FROM workspace_members WHERE member_id = ANY(%s))", arg(filter.MemberIDs))
+	}
+
+	if len(filter.WorkspaceIDs) > 0 {
+		conds = append(conds, fmt.Sprintf("workspace_id = ANY(%s)", arg(filter.WorkspaceIDs)))
+	}
+	if len(filter.OwnerIDs) > 0 && len(filter.MemberIDs) > 0 {
+		conds = append(conds, fmt.Sprintf("(owner_id = ANY(%s) OR %s)", arg(filter.OwnerIDs), members()))
+	} else if len(filter.OwnerIDs) > 0 {
+		conds = append(conds, fmt.Sprintf("owner_id = ANY(%s)", arg(filter.OwnerIDs)))
+	} else if len(filter.MemberIDs) > 0 {
+		conds = append(conds, members())
+	}
+
+	if len(conds) == 0 {
+		return "", nil
+	}
+	return "WHERE " + strings.Join(conds, " AND "), args
+}
@@ -0,0 +1,184 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-funcards/board-service/internal/board"
+	"github.com/go-funcards/mongodb"
+	"github.com/rs/zerolog"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"sync/atomic"
+)
+
+var _ board.QuotaStorage = (*quotaStorage)(nil)
+
+const quotaCollection = "quotas"
+
+// quotaStorage keeps one document per owner holding optional limit overrides
+// next to the number of boards the owner has, which is used to reserve new
+// boards atomically.
+type quotaStorage struct {
+	c        *mongo.Collection
+	boards   *mongo.Collection
+	defaults atomic.Value
+	opts     Options
+	log      zerolog.Logger
+}
+
+// NewQuotaStorage bounds its operations by the "quota" timeout of opts.
+func NewQuotaStorage(db *mongo.Database, defaults board.Limits, opts Options, log zerolog.Logger) *quotaStorage {
+	s := &quotaStorage{
+		c:      db.Collection(quotaCollection),
+		boards: db.Collection(collection),
+		opts:   opts,
+		log:    log.With().Str("storage", "mongodb").Str("collection", quotaCollection).Logger(),
+	}
+	s.SetDefaults(defaults)
+	return s
+}
+
+func (s *quotaStorage) SetDefaults(defaults board.Limits) {
+	s.defaults.Store(defaults)
+}
+
+func (s *quotaStorage) Limits(ctx context.Context, ownerID string) (board.Limits, error) {
+	defaults := s.defaults.Load().(board.Limits)
+
+	ctx, cancel := context.WithTimeout(ctx, s.opts.OpTimeout("quota"))
+	defer cancel()
+
+	override, err := mongodb.DecodeOne[board.Limits](s.c.FindOne(ctx, bson.M{"_id": ownerID},
+		options.FindOne().SetProjection(bson.M{"max_boards": 1, "max_members": 1})))
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return defaults, nil
+	}
+	if err != nil {
+		return defaults, err
+	}
+	return defaults.Override(override), nil
+}
+
+func (s *quotaStorage) Usage(ctx context.Context, ownerID string) (board.Usage, error) {
+	limits, err := s.Limits(ctx, ownerID)
+	if err != nil {
+		return board.Usage{}, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.opts.OpTimeout("quota"))
+	defer cancel()
+
+	// with a tenant, only the boards in its workspaces are counted
+	match := bson.M{"owner_id": ownerID}
+	if t, ok := board.TenantFromContext(ctx); ok {
+		match["workspace_id"] = bson.M{"$in": t.WorkspaceIDs}
+	}
+
+	members := bson.M{"$size": bson.M{"$ifNull": bson.A{"$members", bson.A{}}}}
+	cur, err := s.boards.Aggregate(ctx, mongo.Pipeline{
+		{{"$match", match}},
+		{{"$group", bson.M{
+			"_id":                   "$owner_id",
+			"boards":                bson.M{"$sum": 1},
+			"members":               bson.M{"$sum": members},
+			"largest_board_members": bson.M{"$max": members},
+		}}},
+	})
+	if err != nil {
+		return board.Usage{}, fmt.Errorf(mongodb.ErrMsgQuery, err)
+	}
+
+	data, err := mongodb.DecodeAll[board.Usage](ctx, cur)
+	if err != nil {
+		return board.Usage{}, err
+	}
+
+	usage := board.Usage{OwnerID: ownerID}
+	if len(data) > 0 {
+		usage = data[0]
+	}
+	usage.Limits = limits
+
+	return usage, nil
+}
+
+// reserve counts a new board of the owner unless that would exceed limit.
+func (s *quotaStorage) reserve(ctx context.Context, ownerID string, limit uint64) error {
+	ctx, cancel := context.WithTimeout(ctx, s.opts.OpTimeout("quota"))
+	defer cancel()
+
+	filter := bson.M{"_id": ownerID, "boards": bson.M{"$exists": true}}
+	if limit > 0 {
+		filter["boards"] = bson.M{"$lt": limit}
+	}
+
+	for seeding := true; ; seeding = false {
+		result, err := s.c.UpdateOne(ctx, filter, bson.M{"$inc": bson.M{"boards": 1}})
+		if err != nil {
+			return fmt.Errorf(mongodb.ErrMsgQuery, err)
+		}
+		if result.MatchedCount > 0 {
+			return nil
+		}
+		if !seeding {
+			return board.BoardQuotaExceeded(ownerID, limit)
+		}
+
+		seeded, err := s.seed(ctx, ownerID, limit)
+		if err != nil || seeded {
+			return err
+		}
+	}
+}
+
+// seed reserves a board of an owner without a counter yet, in the write that
+// starts the counter from the boards the owner already has. That write only
+// applies while there is still no counter, it reports false when one was
+// started concurrently for the board to be reserved against it.
+func (s *quotaStorage) seed(ctx context.Context, ownerID string, limit uint64) (bool, error) {
+	n, err := s.boards.CountDocuments(ctx, bson.M{"owner_id": ownerID})
+	if err != nil {
+		return false, fmt.Errorf(mongodb.ErrMsgQuery, err)
+	}
+	if limit > 0 && uint64(n) >= limit {
+		return false, board.BoardQuotaExceeded(ownerID, limit)
+	}
+
+	// the document may exist with overrides only, so the counter is set on
+	// insert and on update alike
+	_, err = s.c.UpdateOne(ctx,
+		bson.M{"_id": ownerID, "boards": bson.M{"$exists": false}},
+		mongo.Pipeline{{{"$set", bson.M{"boards": bson.M{"$add": bson.A{bson.M{"$ifNull": bson.A{"$boards", n}}, 1}}}}}},
+		options.Update().SetUpsert(true),
+	)
+	if mongo.IsDuplicateKeyError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf(mongodb.ErrMsgQuery, err)
+	}
+
+	s.log.Debug().Str("owner_id", ownerID).Int64("boards", n).Msg("board counter seeded")
+
+	return true, nil
+}
+
+// release gives back boards reserved by, or deleted from, the owner.
+func (s *quotaStorage) release(ctx context.Context, ownerID string, n int) {
+	if n == 0 || len(ownerID) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.opts.OpTimeout("quota"))
+	defer cancel()
+
+	_, err := s.c.UpdateOne(ctx,
+		bson.M{"_id": ownerID, "boards": bson.M{"$gte": n}},
+		bson.M{"$inc": bson.M{"boards": -n}},
+	)
+	if err != nil {
+		s.log.Error().Err(err).Str("owner_id", ownerID).Int("boards", n).Msg("board counter not released")
+	}
+}
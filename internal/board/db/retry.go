@@ -0,0 +1,113 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"github.com/jackc/pgconn"
+	"go.mongodb.org/mongo-driver/mongo"
+	"math/rand"
+	"net"
+	"time"
+)
+
+// Server error codes returned before an operation is applied: the node is
+// not, or is no longer, the primary, or the write lost a conflict.
+var notAppliedCodes = []int{10107, 13435, 13436, 11600, 11602, 189, 91, 112}
+
+// Error codes of failures which may have happened after the operation was applied.
+var networkCodes = []int{6, 7, 89, 9001}
+
+// Postgres errors which roll the whole transaction back: a serialization
+// failure and a deadlock.
+var rolledBackStates = []string{"40001", "40P01"}
+
+type RetryPolicy struct {
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// Do calls fn until it succeeds, fails with a permanent error, ctx is done or
+// MaxAttempts are made, sleeping with exponential backoff and full jitter in
+// between. Operations which are not idempotent are only retried when the whole
+// command was rejected with an error guaranteeing nothing was applied.
+func (p RetryPolicy) Do(ctx context.Context, idempotent bool, fn func(ctx context.Context) error) error {
+	backoff := p.InitialBackoff
+	for attempt := 1; ; attempt++ {
+		err := fn(ctx)
+		if err == nil || attempt >= p.MaxAttempts || !retryable(err, idempotent) {
+			return err
+		}
+
+		wait := time.Duration(rand.Int63n(int64(backoff) + 1))
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(wait):
+		}
+
+		if backoff *= 2; p.MaxBackoff > 0 && backoff > p.MaxBackoff {
+			backoff = p.MaxBackoff
+		}
+	}
+}
+
+func retryable(err error, idempotent bool) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var pe *pgconn.PgError
+	if errors.As(err, &pe) {
+		for _, code := range rolledBackStates {
+			if pe.Code == code {
+				return true
+			}
+		}
+		return false
+	}
+	if pgconn.SafeToRetry(err) {
+		return true
+	}
+
+	var se mongo.ServerError
+	if errors.As(err, &se) && (idempotent || !written(err)) {
+		for _, code := range notAppliedCodes {
+			if se.HasErrorCode(code) {
+				return true
+			}
+		}
+	}
+
+	if !idempotent {
+		return false
+	}
+
+	var ne net.Error
+	if mongo.IsNetworkError(err) || errors.As(err, &ne) {
+		return true
+	}
+	if errors.As(err, &se) {
+		for _, code := range networkCodes {
+			if se.HasErrorCode(code) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// written reports whether the server got as far as writing: errors of single
+// writes and write concern errors both come after the write was attempted, so
+// their codes say nothing about what was applied.
+func written(err error) bool {
+	var we mongo.WriteException
+	if errors.As(err, &we) {
+		return len(we.WriteErrors) > 0 || we.WriteConcernError != nil
+	}
+	var bwe mongo.BulkWriteException
+	if errors.As(err, &bwe) {
+		return len(bwe.WriteErrors) > 0 || bwe.WriteConcernError != nil
+	}
+	return false
+}
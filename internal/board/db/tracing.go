@@ -0,0 +1,58 @@
+package db
+
+import (
+	"context"
+	"github.com/go-funcards/board-service/internal/board"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+	"time"
+)
+
+var tracer = otel.Tracer("github.com/go-funcards/board-service/internal/board/db")
+
+// instrument starts a child span for a storage operation. The returned func
+// ends it and records the operation latency, with attributes known only once
+// the operation completed.
+func instrument(ctx context.Context, c *mongo.Collection, operation string, attrs ...attribute.KeyValue) (context.Context, func(error, ...attribute.KeyValue)) {
+	start := time.Now()
+	ctx, span := tracer.Start(ctx, "storage."+operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(semconv.DBSystemMongoDB, semconv.DBName(c.Database().Name()), semconv.DBMongoDBCollection(c.Name()), semconv.DBOperation(operation)),
+		trace.WithAttributes(attrs...),
+	)
+
+	return ctx, func(err error, attrs ...attribute.KeyValue) {
+		span.SetAttributes(attrs...)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+
+		observe(operation, start, err)
+	}
+}
+
+func filterAttributes(filter board.Filter) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.Int("board.filter.board_ids", len(filter.BoardIDs)),
+		attribute.Int("board.filter.workspace_ids", len(filter.WorkspaceIDs)),
+		attribute.Int("board.filter.owner_ids", len(filter.OwnerIDs)),
+		attribute.Int("board.filter.member_ids", len(filter.MemberIDs)),
+		attribute.Int("board.filter.member_workspace_ids", len(filter.MemberWorkspaceIDs)),
+		attribute.Int("board.filter.fields", len(filter.Fields)),
+	}
+}
+
+func countErrors(errs []error) (n int) {
+	for _, err := range errs {
+		if err != nil {
+			n++
+		}
+	}
+	return
+}
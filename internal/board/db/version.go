@@ -0,0 +1,151 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-funcards/board-service/internal/board"
+	"github.com/go-funcards/mongodb"
+	"github.com/go-funcards/slice"
+	"github.com/rs/zerolog"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var _ board.VersionStorage = (*versionStorage)(nil)
+
+const versionCollection = "board_versions"
+
+type versionStorage struct {
+	c        *mongo.Collection
+	maxCount uint64
+	opts     Options
+	log      zerolog.Logger
+}
+
+// NewVersionStorage keeps at most maxCount versions per board (0 keeps all).
+// Versions older than history.max_age expire through the TTL index declared
+// in Indexes. Operations are bounded by the "versions" timeout of opts.
+func NewVersionStorage(db *mongo.Database, maxCount uint64, opts Options, log zerolog.Logger) *versionStorage {
+	return &versionStorage{
+		c:        db.Collection(versionCollection),
+		maxCount: maxCount,
+		opts:     opts,
+		log:      log.With().Str("storage", "mongodb").Str("collection", versionCollection).Logger(),
+	}
+}
+
+func (s *versionStorage) Append(ctx context.Context, versions ...board.BoardVersion) error {
+	if len(versions) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.opts.OpTimeout("versions"))
+	defer cancel()
+
+	docs := slice.Map(versions, func(item board.BoardVersion) any {
+		return item
+	})
+
+	// a recorded version is never replaced, the others are still inserted
+	var conflict error
+	_, err := s.c.InsertMany(ctx, docs, options.InsertMany().SetOrdered(false))
+	if err != nil {
+		var bwe mongo.BulkWriteException
+		if !errors.As(err, &bwe) || bwe.WriteConcernError != nil || !duplicates(bwe.WriteErrors) {
+			return fmt.Errorf(mongodb.ErrMsgQuery, err)
+		}
+		v := versions[bwe.WriteErrors[0].Index]
+		conflict = board.AlreadyExists(board.ResourceBoardVersion, fmt.Sprintf("%s@%d", v.BoardID, v.Version), nil)
+	}
+
+	s.log.Debug().Int("count", len(docs)).Msg("board versions appended")
+
+	if s.maxCount == 0 {
+		return conflict
+	}
+
+	var write []mongo.WriteModel
+	for _, v := range versions {
+		if v.Version > s.maxCount {
+			write = append(write, mongo.NewDeleteManyModel().SetFilter(bson.M{
+				"board_id": v.BoardID,
+				"version":  bson.M{"$lte": v.Version - s.maxCount},
+			}))
+		}
+	}
+	if len(write) == 0 {
+		return conflict
+	}
+
+	if _, err = s.c.BulkWrite(ctx, write, options.BulkWrite().SetOrdered(false)); err != nil {
+		return fmt.Errorf(mongodb.ErrMsgQuery, err)
+	}
+
+	return conflict
+}
+
+func duplicates(errs []mongo.BulkWriteError) bool {
+	for _, err := range errs {
+		if !mongo.IsDuplicateKeyError(err) {
+			return false
+		}
+	}
+	return len(errs) > 0
+}
+
+func (s *versionStorage) FindOne(ctx context.Context, boardID string, version uint64) (board.BoardVersion, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.opts.OpTimeout("versions"))
+	defer cancel()
+
+	data, err := mongodb.DecodeOne[board.BoardVersion](s.c.FindOne(ctx, bson.M{
+		"board_id": boardID,
+		"version":  version,
+	}))
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return data, board.NotFound(board.ResourceBoardVersion, fmt.Sprintf("%s@%d", boardID, version), err)
+	}
+	return data, err
+}
+
+func (s *versionStorage) Find(ctx context.Context, boardID string, index uint64, size uint32) ([]board.BoardVersion, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.opts.OpTimeout("versions"))
+	defer cancel()
+
+	opts := mongodb.FindOptions(index, size).SetSort(bson.D{{"version", -1}})
+	cur, err := s.c.Find(ctx, bson.M{"board_id": boardID}, opts)
+	if err != nil {
+		return nil, fmt.Errorf(mongodb.ErrMsgQuery, err)
+	}
+	return mongodb.DecodeAll[board.BoardVersion](ctx, cur)
+}
+
+func (s *versionStorage) Count(ctx context.Context, boardID string) (uint64, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.opts.OpTimeout("versions"))
+	defer cancel()
+
+	total, err := s.c.CountDocuments(ctx, bson.M{"board_id": boardID})
+	if err != nil {
+		return 0, fmt.Errorf(mongodb.ErrMsgQuery, err)
+	}
+	return uint64(total), nil
+}
+
+func (s *versionStorage) Purge(ctx context.Context, boardIDs ...string) error {
+	if len(boardIDs) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.opts.OpTimeout("versions"))
+	defer cancel()
+
+	res, err := s.c.DeleteMany(ctx, mongodb.Filter{in("board_id", boardIDs)}.Build())
+	if err != nil {
+		return fmt.Errorf(mongodb.ErrMsgQuery, err)
+	}
+
+	s.log.Debug().Int64("count", res.DeletedCount).Msg("board versions purged")
+
+	return nil
+}
@@ -0,0 +1,272 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-funcards/board-service/internal/board"
+	"github.com/go-funcards/board-service/internal/tracing"
+	"github.com/go-funcards/mongodb"
+	"github.com/go-funcards/slice"
+	"github.com/rs/zerolog"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opentelemetry.io/otel/attribute"
+	"time"
+)
+
+var _ board.WorkspaceStorage = (*workspaceStorage)(nil)
+
+const workspaceCollection = "workspaces"
+
+type workspaceStorage struct {
+	c      *mongo.Collection
+	boards *mongo.Collection
+	opts   Options
+	log    zerolog.Logger
+}
+
+func NewWorkspaceStorage(db *mongo.Database, opts Options, log zerolog.Logger) *workspaceStorage {
+	return &workspaceStorage{
+		c:      db.Collection(workspaceCollection),
+		boards: db.Collection(collection),
+		opts:   opts,
+		log:    log.With().Str("storage", "mongodb").Str("collection", workspaceCollection).Logger(),
+	}
+}
+
+func (s *workspaceStorage) Create(ctx context.Context, model board.Workspace) (err error) {
+	ctx, finish := instrument(ctx, s.c, "workspace_create",
+		attribute.String("workspace.id", model.WorkspaceID),
+		attribute.Int("workspace.members", len(model.Members)),
+	)
+	defer func() { finish(err) }()
+
+	ctx, cancel := context.WithTimeout(ctx, s.opts.OpTimeout("save"))
+	defer cancel()
+
+	model.Members = slice.Filter(model.Members, func(item board.Member) bool {
+		return !item.Delete
+	})
+
+	tracing.Logger(ctx, s.log).Info().Str("workspace_id", model.WorkspaceID).Msg("workspace create")
+
+	err = s.opts.Retry.Do(ctx, false, func(ctx context.Context) (err error) {
+		_, err = s.c.InsertOne(ctx, model)
+		return err
+	})
+	if mongo.IsDuplicateKeyError(err) {
+		return board.AlreadyExists(board.ResourceWorkspace, model.WorkspaceID, err)
+	}
+	if err != nil {
+		return fmt.Errorf(mongodb.ErrMsgQuery, err)
+	}
+
+	return nil
+}
+
+// Update merges model into the workspace like the boards are, in a single
+// pipeline update: the members being changed are removed and the others
+// added back with their new roles.
+func (s *workspaceStorage) Update(ctx context.Context, model board.Workspace) (err error) {
+	ctx, finish := instrument(ctx, s.c, "workspace_update",
+		attribute.String("workspace.id", model.WorkspaceID),
+		attribute.Int("workspace.members", len(model.Members)),
+	)
+	defer func() { finish(err) }()
+
+	ctx, cancel := context.WithTimeout(ctx, s.opts.OpTimeout("save"))
+	defer cancel()
+
+	changed := slice.Map(model.Members, func(item board.Member) string {
+		return item.MemberID
+	})
+	added := make(map[string]bool)
+	addMembers := slice.Filter(model.Members, func(item board.Member) bool {
+		if item.Delete || added[item.MemberID] {
+			return false
+		}
+		added[item.MemberID] = true
+		return true
+	})
+
+	// values are literals, a name starting with $ is not a field path
+	set := bson.M{
+		"members": bson.M{"$concatArrays": bson.A{
+			bson.M{"$filter": bson.M{
+				"input": bson.M{"$ifNull": bson.A{"$members", bson.A{}}},
+				"cond":  bson.M{"$not": bson.A{bson.M{"$in": bson.A{"$$this.member_id", bson.M{"$literal": changed}}}}},
+			}},
+			bson.M{"$literal": addMembers},
+		}},
+	}
+	if len(model.Name) > 0 {
+		set["name"] = bson.M{"$literal": model.Name}
+	}
+
+	tracing.Logger(ctx, s.log).Info().Str("workspace_id", model.WorkspaceID).Msg("workspace update")
+
+	var result *mongo.UpdateResult
+	err = s.opts.Retry.Do(ctx, false, func(ctx context.Context) (err error) {
+		result, err = s.c.UpdateOne(ctx, bson.M{"_id": model.WorkspaceID}, mongo.Pipeline{{{"$set", set}}})
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf(mongodb.ErrMsgQuery, err)
+	}
+	if result.MatchedCount == 0 {
+		return board.NotFound(board.ResourceWorkspace, model.WorkspaceID, mongo.ErrNoDocuments)
+	}
+
+	return nil
+}
+
+// deleting matches the workspaces marked by a Delete still in progress.
+var deleting = bson.M{"$gt": bson.A{"$deleting_until", "$$NOW"}}
+
+// Delete marks the workspace as being deleted for as long as the delete may
+// take, counts its boards, and removes it only while still marked. Boards
+// added to a workspace check that it is not marked once they are written,
+// and are taken back otherwise: a board written concurrently is either
+// counted or not written.
+func (s *workspaceStorage) Delete(ctx context.Context, id string) (err error) {
+	ctx, finish := instrument(ctx, s.c, "workspace_delete", attribute.String("workspace.id", id))
+	defer func() { finish(err) }()
+
+	lease := s.opts.OpTimeout("delete")
+	ctx, cancel := context.WithTimeout(ctx, lease)
+	defer cancel()
+
+	token := primitive.NewObjectID().Hex()
+	var result *mongo.UpdateResult
+	err = s.opts.Retry.Do(ctx, false, func(ctx context.Context) (err error) {
+		result, err = s.c.UpdateOne(ctx,
+			bson.M{"_id": id, "$expr": bson.M{"$not": bson.A{deleting}}},
+			mongo.Pipeline{{{"$set", bson.M{
+				"deleting":       token,
+				"deleting_until": bson.M{"$add": bson.A{"$$NOW", lease.Milliseconds()}},
+			}}}},
+		)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf(mongodb.ErrMsgQuery, err)
+	}
+	if result.MatchedCount == 0 {
+		n, err := s.c.CountDocuments(ctx, bson.M{"_id": id})
+		if err != nil {
+			return fmt.Errorf(mongodb.ErrMsgQuery, err)
+		}
+		if n == 0 {
+			return board.NotFound(board.ResourceWorkspace, id, mongo.ErrNoDocuments)
+		}
+		return board.Conflict(board.ResourceWorkspace, id, "workspace is being deleted")
+	}
+
+	marked := bson.M{"_id": id, "deleting": token, "$expr": deleting}
+
+	n, err := s.boards.CountDocuments(ctx, bson.M{"workspace_id": id})
+	if err == nil && n == 0 {
+		var deleted *mongo.DeleteResult
+		if deleted, err = s.c.DeleteOne(ctx, marked); err == nil && deleted.DeletedCount == 1 {
+			tracing.Logger(ctx, s.log).Debug().Str("workspace_id", id).Msg("workspace deleted")
+			return nil
+		}
+		if err == nil {
+			return board.Conflict(board.ResourceWorkspace, id, "workspace delete outlasted its mark")
+		}
+	}
+
+	if _, uerr := s.c.UpdateOne(ctx, marked, bson.M{"$unset": bson.M{"deleting": "", "deleting_until": ""}}); uerr != nil {
+		tracing.Logger(ctx, s.log).Error().Err(uerr).Str("workspace_id", id).Msg("workspace left marked until its mark expires")
+	}
+	if err != nil {
+		return fmt.Errorf(mongodb.ErrMsgQuery, err)
+	}
+	return board.Conflict(board.ResourceWorkspace, id, fmt.Sprintf("workspace still has %d boards", n))
+}
+
+// active fails with NotFound when there is no workspace with the id, unless
+// it is empty, and with Conflict while it is being deleted.
+func active(ctx context.Context, c *mongo.Collection, policy RetryPolicy, id string) error {
+	if len(id) == 0 {
+		return nil
+	}
+
+	var w bson.M
+	err := policy.Do(ctx, true, func(ctx context.Context) (err error) {
+		w, err = mongodb.DecodeOne[bson.M](c.FindOne(ctx, bson.M{"_id": id},
+			options.FindOne().SetProjection(bson.M{"deleting_until": 1})))
+		return err
+	})
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return board.NotFound(board.ResourceWorkspace, id, err)
+	}
+	if err != nil {
+		return err
+	}
+	if until, ok := w["deleting_until"].(primitive.DateTime); ok && until.Time().After(time.Now()) {
+		return board.Conflict(board.ResourceWorkspace, id, "workspace is being deleted")
+	}
+	return nil
+}
+
+func (s *workspaceStorage) Find(ctx context.Context, filter board.WorkspaceFilter, index uint64, size uint32) (data []board.Workspace, err error) {
+	ctx, finish := instrument(ctx, s.c, "workspace_find",
+		attribute.Int64("workspace.page.index", int64(index)),
+		attribute.Int64("workspace.page.size", int64(size)),
+	)
+	defer func() { finish(err, attribute.Int("workspace.results", len(data))) }()
+
+	ctx, cancel := context.WithTimeout(ctx, s.opts.OpTimeout("find"))
+	defer cancel()
+
+	opts := mongodb.FindOptions(index, size).SetSort(bson.D{{"created_at", -1}})
+	err = s.opts.Retry.Do(ctx, true, func(ctx context.Context) error {
+		cur, err := s.c.Find(ctx, s.build(filter), opts)
+		if err != nil {
+			return fmt.Errorf(mongodb.ErrMsgQuery, err)
+		}
+		data, err = mongodb.DecodeAll[board.Workspace](ctx, cur)
+		return err
+	})
+	return data, err
+}
+
+func (s *workspaceStorage) Count(ctx context.Context, filter board.WorkspaceFilter) (total uint64, err error) {
+	ctx, finish := instrument(ctx, s.c, "workspace_count")
+	defer func() { finish(err, attribute.Int64("workspace.total", int64(total))) }()
+
+	ctx, cancel := context.WithTimeout(ctx, s.opts.OpTimeout("count"))
+	defer cancel()
+
+	var n int64
+	err = s.opts.Retry.Do(ctx, true, func(ctx context.Context) (err error) {
+		n, err = s.c.CountDocuments(ctx, s.build(filter))
+		return err
+	})
+	if err != nil {
+		return 0, fmt.Errorf(mongodb.ErrMsgQuery, err)
+	}
+	return uint64(n), nil
+}
+
+func (s *workspaceStorage) build(filter board.WorkspaceFilter) any {
+	f := make(mongodb.Filter, 0)
+	if len(filter.WorkspaceIDs) > 0 {
+		f = append(f, in("_id", filter.WorkspaceIDs))
+	}
+	if len(filter.OwnerIDs) > 0 && len(filter.MemberIDs) > 0 {
+		f = append(f, mongodb.Or(
+			in("owner_id", filter.OwnerIDs),
+			in("members.member_id", filter.MemberIDs),
+		))
+	} else if len(filter.OwnerIDs) > 0 {
+		f = append(f, in("owner_id", filter.OwnerIDs))
+	} else if len(filter.MemberIDs) > 0 {
+		f = append(f, in("members.member_id", filter.MemberIDs))
+	}
+	return f.Build()
+}
@@ -0,0 +1,182 @@
+package board
+
+import (
+	"fmt"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+const ErrorDomain = "board.funcards.org"
+
+const (
+	ResourceBoard        = "board"
+	ResourceBoardVersion = "board_version"
+	ResourceOwner        = "owner"
+	ResourceWorkspace    = "workspace"
+)
+
+const (
+	ReasonNotFound         = "NOT_FOUND"
+	ReasonAlreadyExists    = "ALREADY_EXISTS"
+	ReasonConflict         = "CONFLICT"
+	ReasonInvalidArgument  = "INVALID_ARGUMENT"
+	ReasonPermissionDenied = "PERMISSION_DENIED"
+	ReasonQuotaExceeded    = "QUOTA_EXCEEDED"
+	ReasonUnauthenticated  = "UNAUTHENTICATED"
+)
+
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+type QuotaViolation struct {
+	Subject     string
+	Description string
+}
+
+// Error is a domain error carrying everything needed to build a detailed gRPC
+// status: ErrorInfo always, ResourceInfo when a resource is set and BadRequest
+// when there are field violations, QuotaFailure when there are quota violations.
+type Error struct {
+	Code       codes.Code
+	Reason     string
+	Message    string
+	Resource   string
+	Name       string
+	Violations []FieldViolation
+	Quota      []QuotaViolation
+	Err        error
+}
+
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("%s: %v", e.Message, e.Err)
+	}
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+func (e *Error) GRPCStatus() *status.Status {
+	info := &errdetails.ErrorInfo{Reason: e.Reason, Domain: ErrorDomain}
+	if len(e.Resource) > 0 {
+		info.Metadata = map[string]string{e.Resource + "_id": e.Name}
+	}
+
+	details := []protoadapt.MessageV1{info}
+	if len(e.Resource) > 0 {
+		details = append(details, &errdetails.ResourceInfo{
+			ResourceType: e.Resource,
+			ResourceName: e.Name,
+			Description:  e.Message,
+		})
+	}
+	if len(e.Violations) > 0 {
+		br := new(errdetails.BadRequest)
+		for _, v := range e.Violations {
+			br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       v.Field,
+				Description: v.Description,
+			})
+		}
+		details = append(details, br)
+	}
+	if len(e.Quota) > 0 {
+		qf := new(errdetails.QuotaFailure)
+		for _, v := range e.Quota {
+			qf.Violations = append(qf.Violations, &errdetails.QuotaFailure_Violation{
+				Subject:     v.Subject,
+				Description: v.Description,
+			})
+		}
+		details = append(details, qf)
+	}
+
+	st := status.New(e.Code, e.Message)
+	if ds, err := st.WithDetails(details...); err == nil {
+		return ds
+	}
+	return st
+}
+
+func NotFound(resource, name string, err error) *Error {
+	return &Error{
+		Code:     codes.NotFound,
+		Reason:   ReasonNotFound,
+		Message:  describe(resource, name, "not found"),
+		Resource: resource,
+		Name:     name,
+		Err:      err,
+	}
+}
+
+func AlreadyExists(resource, name string, err error) *Error {
+	return &Error{
+		Code:     codes.AlreadyExists,
+		Reason:   ReasonAlreadyExists,
+		Message:  describe(resource, name, "already exists"),
+		Resource: resource,
+		Name:     name,
+		Err:      err,
+	}
+}
+
+func Conflict(resource, name, message string) *Error {
+	return &Error{
+		Code:     codes.Aborted,
+		Reason:   ReasonConflict,
+		Message:  message,
+		Resource: resource,
+		Name:     name,
+	}
+}
+
+func InvalidArgument(violations ...FieldViolation) *Error {
+	return &Error{
+		Code:       codes.InvalidArgument,
+		Reason:     ReasonInvalidArgument,
+		Message:    "invalid request",
+		Violations: violations,
+	}
+}
+
+func PermissionDenied(resource, name, message string) *Error {
+	return &Error{
+		Code:     codes.PermissionDenied,
+		Reason:   ReasonPermissionDenied,
+		Message:  message,
+		Resource: resource,
+		Name:     name,
+	}
+}
+
+func Unauthenticated(message string) *Error {
+	return &Error{
+		Code:    codes.Unauthenticated,
+		Reason:  ReasonUnauthenticated,
+		Message: message,
+	}
+}
+
+func QuotaExceeded(resource, name string, violations ...QuotaViolation) *Error {
+	return &Error{
+		Code:     codes.ResourceExhausted,
+		Reason:   ReasonQuotaExceeded,
+		Message:  describe(resource, name, "exceeds quota"),
+		Resource: resource,
+		Name:     name,
+		Quota:    violations,
+	}
+}
+
+func describe(resource, name, state string) string {
+	if len(name) == 0 {
+		return fmt.Sprintf("%s %s", resource, state)
+	}
+	return fmt.Sprintf("%s %s %s", resource, name, state)
+}
@@ -0,0 +1,332 @@
+package board
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/go-funcards/board-service/proto/v1"
+	"github.com/go-funcards/slice"
+	"github.com/google/uuid"
+	"io"
+	"time"
+)
+
+// Exports are JSON Lines documents: an ExportHeader line, then a Board line
+// per board. Readers accept every version up to ExportVersion.
+const (
+	ExportFormat  = "board-service/boards"
+	ExportVersion = 1
+)
+
+const (
+	exportPageSize  = 500
+	exportChunkSize = 64 << 10
+	importBatchSize = 100
+	importMaxLine   = 4 << 20
+)
+
+type ExportHeader struct {
+	Format     string    `json:"format"`
+	Version    int       `json:"version"`
+	ExportedAt time.Time `json:"exported_at"`
+	Filter     Filter    `json:"filter"`
+}
+
+func (h ExportHeader) validate() error {
+	if h.Format != ExportFormat {
+		return fmt.Errorf("format %q is not %q", h.Format, ExportFormat)
+	}
+	if h.Version < 1 || h.Version > ExportVersion {
+		return fmt.Errorf("version %d is not supported, at most %d is", h.Version, ExportVersion)
+	}
+	return nil
+}
+
+func CreateExportFilter(in *v1.ExportBoardsRequest) Filter {
+	return Filter{
+		BoardIDs:  in.GetBoardIds(),
+		OwnerIDs:  in.GetOwnerIds(),
+		MemberIDs: in.GetMemberIds(),
+	}
+}
+
+// ExportBoards writes the boards matching filter to w, newest first. Boards
+// are read page by page, so the export is not a snapshot: a board created or
+// deleted meanwhile may shift the pages.
+func ExportBoards(ctx context.Context, storage Storage, filter Filter, w io.Writer) error {
+	enc := json.NewEncoder(w)
+
+	err := enc.Encode(ExportHeader{
+		Format:     ExportFormat,
+		Version:    ExportVersion,
+		ExportedAt: time.Now().UTC(),
+		Filter:     filter,
+	})
+	if err != nil {
+		return err
+	}
+
+	for index := uint64(0); ; index++ {
+		data, err := storage.Find(ctx, filter, index, exportPageSize)
+		if err != nil {
+			return err
+		}
+		for _, item := range data {
+			if err = enc.Encode(item); err != nil {
+				return err
+			}
+		}
+		if len(data) < exportPageSize {
+			return nil
+		}
+	}
+}
+
+// importer writes the boards of an export through the storage, batch by
+// batch, checking each against the rules of CreateBoardRequest.
+type importer struct {
+	storage   Storage
+	rules     *ValidatorRef
+	mode      v1.ImportMode
+	remap     bool
+	workspace string
+	res       *v1.ImportBoardsResponse
+}
+
+// ImportBoards reads an export from r. Boards that already exist are skipped,
+// overwritten or stop the import by mode; with IMPORT_MODE_FAIL the batches
+// before the conflicting one stay imported. With remap every board gets a new
+// id, so none conflicts. With a workspace every board is imported into it,
+// otherwise into the workspace it was exported from.
+//
+// An overwritten board takes the name, metadata, members and owner of the
+// export and keeps its creation time.
+func ImportBoards(ctx context.Context, storage Storage, rules *ValidatorRef, mode v1.ImportMode, remap bool, workspace string, r io.Reader) (*v1.ImportBoardsResponse, error) {
+	im := &importer{
+		storage:   storage,
+		rules:     rules,
+		mode:      mode,
+		remap:     remap,
+		workspace: workspace,
+		res:       &v1.ImportBoardsResponse{},
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, importMaxLine)
+
+	var header ExportHeader
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+		return nil, InvalidArgument(FieldViolation{Field: "data", Description: "import is empty"})
+	}
+	if err := json.Unmarshal(scanner.Bytes(), &header); err != nil {
+		return nil, invalidLine(1, err)
+	}
+	if err := header.validate(); err != nil {
+		return nil, invalidLine(1, err)
+	}
+
+	batch := make([]Board, 0, importBatchSize)
+	for line := 2; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var item Board
+		if err := json.Unmarshal(scanner.Bytes(), &item); err != nil {
+			return nil, invalidLine(line, err)
+		}
+
+		if batch = append(batch, item); len(batch) == importBatchSize {
+			if err := im.flush(ctx, batch); err != nil {
+				return nil, err
+			}
+			batch = batch[:0]
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		if errors.Is(err, bufio.ErrTooLong) {
+			return nil, InvalidArgument(FieldViolation{
+				Field:       "data",
+				Description: fmt.Sprintf("line longer than %d bytes", importMaxLine),
+			})
+		}
+		return nil, err
+	}
+
+	if err := im.flush(ctx, batch); err != nil {
+		return nil, err
+	}
+	return im.res, nil
+}
+
+func (im *importer) flush(ctx context.Context, batch []Board) error {
+	var valid []Board
+	for _, item := range batch {
+		if err := validateRequest(im.rules.Load(), importRequest(item)); err != nil {
+			im.fail(item.BoardID, err)
+			continue
+		}
+		if im.remap {
+			if im.res.RemappedIds == nil {
+				im.res.RemappedIds = make(map[string]string)
+			}
+			id := uuid.NewString()
+			im.res.RemappedIds[item.BoardID] = id
+			item.BoardID = id
+		}
+		if len(im.workspace) > 0 {
+			item.WorkspaceID = im.workspace
+		}
+		valid = append(valid, item)
+	}
+	if len(valid) == 0 {
+		return nil
+	}
+
+	existing := map[string]Board{}
+	if !im.remap {
+		data, err := im.storage.Find(ctx, Filter{BoardIDs: slice.Map(valid, func(item Board) string {
+			return item.BoardID
+		})}, 0, uint32(len(valid)))
+		if err != nil {
+			return err
+		}
+		for _, item := range data {
+			existing[item.BoardID] = item
+		}
+	}
+
+	// a board is written once per batch, the last of its lines replacing the others
+	var models []Board
+	lines := make(map[string]int)
+	repeats := make(map[string]int)
+	for _, item := range valid {
+		current, ok := existing[item.BoardID]
+		i, repeated := lines[item.BoardID]
+		switch {
+		case !ok && !repeated:
+			if item.CreatedAt.IsZero() {
+				item.CreatedAt = time.Now().UTC()
+			}
+			item.Version = 0
+			lines[item.BoardID] = len(models)
+			models = append(models, item)
+		case im.mode == v1.ImportMode_IMPORT_MODE_SKIP:
+			im.res.Skipped++
+		case im.mode == v1.ImportMode_IMPORT_MODE_FAIL:
+			return AlreadyExists(ResourceBoard, item.BoardID, nil)
+		case repeated:
+			item.CreatedAt = models[i].CreatedAt
+			item.Version = models[i].Version
+			item.Replace = true
+			models[i] = item
+			repeats[item.BoardID]++
+		default:
+			item.CreatedAt = current.CreatedAt
+			item.Replace = true
+			lines[item.BoardID] = len(models)
+			models = append(models, item)
+		}
+	}
+	if len(models) == 0 {
+		return nil
+	}
+
+	errs, err := im.storage.SaveMany(ctx, models)
+	if err != nil {
+		return err
+	}
+	for i, model := range models {
+		_, overwrite := existing[model.BoardID]
+		switch {
+		case errs[i] != nil:
+			for n := 0; n <= repeats[model.BoardID]; n++ {
+				im.fail(model.BoardID, errs[i])
+			}
+		case overwrite:
+			im.res.Overwritten += uint64(1 + repeats[model.BoardID])
+		default:
+			im.res.Created++
+			im.res.Overwritten += uint64(repeats[model.BoardID])
+		}
+	}
+
+	return nil
+}
+
+func (im *importer) fail(id string, err error) {
+	message := err.Error()
+	var e *Error
+	if errors.As(err, &e) {
+		for _, v := range e.Violations {
+			message += fmt.Sprintf("; %s: %s", v.Field, v.Description)
+		}
+	}
+
+	im.res.Failures = append(im.res.Failures, &v1.BatchBoardsResponse_Result{
+		BoardId: id,
+		Code:    uint32(batchCode(err)),
+		Message: message,
+	})
+}
+
+// importRequest is the request creating item, so that imported boards obey
+// the rules of created ones.
+func importRequest(item Board) *v1.CreateBoardRequest {
+	return &v1.CreateBoardRequest{
+		BoardId:     item.BoardID,
+		OwnerId:     item.OwnerID,
+		Name:        item.Name,
+		Metadata:    item.Metadata,
+		WorkspaceId: item.WorkspaceID,
+		Members: slice.Map(item.Members, func(m Member) *v1.CreateBoardRequest_Member {
+			return &v1.CreateBoardRequest_Member{MemberId: m.MemberID, Roles: m.Roles}
+		}),
+	}
+}
+
+// chunkWriter sends what is written to it as export chunks.
+type chunkWriter struct {
+	stream v1.Board_ExportBoardsServer
+}
+
+func (w chunkWriter) Write(p []byte) (int, error) {
+	// the stream may hold on to the message, p is reused by the caller
+	data := append([]byte(nil), p...)
+	if err := w.stream.Send(&v1.ExportChunk{Data: data}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// chunkReader reads the data of import chunks, starting with the one already
+// received.
+type chunkReader struct {
+	stream v1.Board_ImportBoardsServer
+	data   []byte
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.data) == 0 {
+		chunk, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		r.data = chunk.GetData()
+	}
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+	return n, nil
+}
+
+func invalidLine(line int, err error) error {
+	return InvalidArgument(FieldViolation{
+		Field:       "data",
+		Description: fmt.Sprintf("line %d: %v", line, err),
+	})
+}
@@ -0,0 +1,128 @@
+package board
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/go-funcards/board-service/proto/v1"
+	"github.com/go-funcards/validate"
+	"google.golang.org/grpc/codes"
+	"testing"
+	"time"
+)
+
+// importStorage saves boards one by one, failing those in fail.
+type importStorage struct {
+	*memoryStorage
+	fail map[string]bool
+}
+
+func (s *importStorage) SaveMany(ctx context.Context, models []Board) ([]error, error) {
+	errs := make([]error, len(models))
+	for i, model := range models {
+		if s.fail[model.BoardID] {
+			errs[i] = Conflict(ResourceBoard, model.BoardID, "board changed during the write")
+			continue
+		}
+		errs[i] = s.Save(ctx, model)
+	}
+	return errs, nil
+}
+
+func export(t *testing.T, boards ...Board) *bytes.Buffer {
+	t.Helper()
+	buf := new(bytes.Buffer)
+	enc := json.NewEncoder(buf)
+	if err := enc.Encode(ExportHeader{Format: ExportFormat, Version: ExportVersion}); err != nil {
+		t.Fatal(err)
+	}
+	for _, b := range boards {
+		if err := enc.Encode(b); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return buf
+}
+
+func importRules(t *testing.T) *ValidatorRef {
+	t.Helper()
+	v, err := NewValidator(validate.TypeRules{"v1.CreateBoardRequest": {"Name": "required"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewValidatorRef(v)
+}
+
+func TestImportOverwriteCountsRepeatedBoards(t *testing.T) {
+	created := time.Date(2022, 7, 1, 12, 0, 0, 0, time.UTC)
+	base := &memoryStorage{boards: map[string]Board{
+		"b1": {BoardID: "b1", OwnerID: "o1", Name: "old", Metadata: `{"old":true}`, CreatedAt: created, Version: 1},
+	}}
+	storage := &importStorage{memoryStorage: base, fail: map[string]bool{"b4": true}}
+
+	data := export(t,
+		Board{BoardID: "b1", OwnerID: "o1", Name: "first"},
+		Board{BoardID: "b2", OwnerID: "o1", Name: "a"},
+		Board{BoardID: "b2", OwnerID: "o1", Name: "b"},
+		Board{BoardID: "b1", OwnerID: "o2", Name: "second"},
+		Board{BoardID: "b3", OwnerID: "o1"},
+		Board{BoardID: "b4", OwnerID: "o1", Name: "c"},
+		Board{BoardID: "b4", OwnerID: "o1", Name: "d"},
+	)
+	res, err := ImportBoards(context.Background(), storage, importRules(t), v1.ImportMode_IMPORT_MODE_OVERWRITE, false, "", data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// b1 is overwritten by both of its lines, b2 created by its first and
+	// overwritten by its second, b3 invalid and b4 failed once per line
+	if res.GetCreated() != 1 || res.GetOverwritten() != 3 || res.GetSkipped() != 0 {
+		t.Fatalf("got %d created, %d overwritten, %d skipped, want 1, 3 and 0", res.GetCreated(), res.GetOverwritten(), res.GetSkipped())
+	}
+	want := []struct {
+		id   string
+		code codes.Code
+	}{{"b3", codes.InvalidArgument}, {"b4", codes.Aborted}, {"b4", codes.Aborted}}
+	if len(res.GetFailures()) != len(want) {
+		t.Fatalf("got failures %v, want %v", res.GetFailures(), want)
+	}
+	for i, f := range res.GetFailures() {
+		if f.GetBoardId() != want[i].id || codes.Code(f.GetCode()) != want[i].code {
+			t.Fatalf("failure %d: got %s with %v, want %s with %v", i, f.GetBoardId(), codes.Code(f.GetCode()), want[i].id, want[i].code)
+		}
+	}
+
+	// the last line of a board wins and replaces it as a whole
+	b1 := base.boards["b1"]
+	if b1.Name != "second" || b1.OwnerID != "o2" || b1.Metadata != "" || !b1.CreatedAt.Equal(created) {
+		t.Fatalf("got %+v, want b1 replaced by its last line, created at %v", b1, created)
+	}
+	if b2 := base.boards["b2"]; b2.Name != "b" {
+		t.Fatalf("got %+v, want b2 named b", b2)
+	}
+	if _, ok := base.boards["b4"]; ok {
+		t.Fatal("got b4 written, want it failed")
+	}
+}
+
+func TestImportSkipCountsRepeatedBoards(t *testing.T) {
+	base := &memoryStorage{boards: map[string]Board{
+		"b1": {BoardID: "b1", OwnerID: "o1", Name: "old", Version: 1},
+	}}
+
+	data := export(t,
+		Board{BoardID: "b1", OwnerID: "o1", Name: "first"},
+		Board{BoardID: "b2", OwnerID: "o1", Name: "a"},
+		Board{BoardID: "b2", OwnerID: "o1", Name: "b"},
+	)
+	res, err := ImportBoards(context.Background(), &importStorage{memoryStorage: base}, importRules(t), v1.ImportMode_IMPORT_MODE_SKIP, false, "", data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.GetCreated() != 1 || res.GetSkipped() != 2 || res.GetOverwritten() != 0 {
+		t.Fatalf("got %d created, %d skipped, %d overwritten, want 1, 2 and 0", res.GetCreated(), res.GetSkipped(), res.GetOverwritten())
+	}
+	if b1, b2 := base.boards["b1"], base.boards["b2"]; b1.Name != "old" || b2.Name != "a" {
+		t.Fatalf("got %q and %q, want b1 untouched and b2 from its first line", b1.Name, b2.Name)
+	}
+}
@@ -11,50 +11,142 @@ type Member struct {
 	MemberID string   `json:"member_id" bson:"member_id,omitempty"`
 	Roles    []string `json:"roles" bson:"roles,omitempty"`
 	Delete   bool     `json:"-" bson:"-"`
+	// Inherited marks a member of the workspace of a board, which is never
+	// stored with the board, see Workspace.
+	Inherited bool `json:"-" bson:"-"`
 }
 
 type Board struct {
-	BoardID   string    `json:"board_id" bson:"_id,omitempty"`
-	OwnerID   string    `json:"owner_id" bson:"owner_id,omitempty"`
-	Name      string    `json:"name" bson:"name,omitempty"`
-	Metadata  string    `json:"metadata" bson:"metadata,omitempty"`
-	CreatedAt time.Time `json:"created_at" bson:"created_at,omitempty"`
-	Members   []Member  `json:"members" bson:"members,omitempty"`
+	BoardID     string    `json:"board_id" bson:"_id,omitempty"`
+	WorkspaceID string    `json:"workspace_id,omitempty" bson:"workspace_id,omitempty"`
+	OwnerID     string    `json:"owner_id" bson:"owner_id,omitempty"`
+	Name        string    `json:"name" bson:"name,omitempty"`
+	Metadata    string    `json:"metadata" bson:"metadata,omitempty"`
+	CreatedAt   time.Time `json:"created_at" bson:"created_at,omitempty"`
+	Members     []Member  `json:"members" bson:"members,omitempty"`
+	Version     uint64    `json:"version" bson:"version,omitempty"`
+	// Replace makes Save write the board as given, in a single write: name,
+	// metadata and members are replaced, empty ones included, and the owner
+	// changes within its limits like by Transfer. The workspace and creation
+	// time of an existing board are kept.
+	Replace bool `json:"-" bson:"-"`
 }
 
+// Merge returns the board a Save of model makes of current, nil when the
+// board is new: empty fields are left untouched, the workspace, owner and
+// creation time are only set on insert, the members being changed are
+// removed before the others are added. With model.Replace the owner, name,
+// metadata and members are replaced instead. The version is incremented.
+func Merge(current *Board, model Board) Board {
+	var next Board
+	if current != nil {
+		next = *current
+	} else {
+		next = Board{
+			BoardID:     model.BoardID,
+			WorkspaceID: model.WorkspaceID,
+			OwnerID:     model.OwnerID,
+			CreatedAt:   model.CreatedAt,
+			Version:     model.Version,
+		}
+	}
+	if model.Replace {
+		next.OwnerID = model.OwnerID
+		next.Name = model.Name
+		next.Metadata = model.Metadata
+	}
+	if len(model.Name) > 0 {
+		next.Name = model.Name
+	}
+	if len(model.Metadata) > 0 {
+		next.Metadata = model.Metadata
+	}
+	next.Version++
+
+	changed := slice.Map(model.Members, func(item Member) string {
+		return item.MemberID
+	})
+	next.Members = nil
+	if current != nil && !model.Replace {
+		next.Members = slice.Filter(current.Members, func(item Member) bool {
+			return !slice.Contains(changed, item.MemberID)
+		})
+	}
+	added := make(map[string]bool)
+	for _, m := range model.Members {
+		if !m.Delete && !added[m.MemberID] {
+			added[m.MemberID] = true
+			next.Members = append(next.Members, Member{MemberID: m.MemberID, Roles: m.Roles})
+		}
+	}
+	return next
+}
+
+// Filter matches boards by id, owner OR member and workspace. Storages
+// scope it to the tenant of the call, see NewTenantStorage.
+//
+// The boards of MemberWorkspaceIDs match like the boards of MemberIDs do,
+// since the members of a workspace are members of its boards as well.
 type Filter struct {
-	BoardIDs  []string `json:"board_ids,omitempty"`
-	OwnerIDs  []string `json:"owner_ids,omitempty"`
-	MemberIDs []string `json:"member_ids,omitempty"`
+	BoardIDs           []string `json:"board_ids,omitempty"`
+	WorkspaceIDs       []string `json:"workspace_ids,omitempty"`
+	OwnerIDs           []string `json:"owner_ids,omitempty"`
+	MemberIDs          []string `json:"member_ids,omitempty"`
+	MemberWorkspaceIDs []string `json:"member_workspace_ids,omitempty"`
+	Fields             []string `json:"fields,omitempty"`
 }
 
-func (b Board) toProto() *v1.BoardsResponse_Board {
-	return &v1.BoardsResponse_Board{
-		BoardId:   b.BoardID,
-		OwnerId:   b.OwnerID,
-		Name:      b.Name,
-		Metadata:  b.Metadata,
-		CreatedAt: timestamppb.New(b.CreatedAt),
-		Members: slice.Map(b.Members, func(m Member) *v1.BoardsResponse_Board_Member {
+func (b Board) toProto(fields []string) *v1.BoardsResponse_Board {
+	has := func(field string) bool {
+		return len(fields) == 0 || slice.Contains(fields, field)
+	}
+
+	out := &v1.BoardsResponse_Board{}
+	if has("board_id") {
+		out.BoardId = b.BoardID
+	}
+	if has("workspace_id") {
+		out.WorkspaceId = b.WorkspaceID
+	}
+	if has("owner_id") {
+		out.OwnerId = b.OwnerID
+	}
+	if has("name") {
+		out.Name = b.Name
+	}
+	if has("metadata") {
+		out.Metadata = b.Metadata
+	}
+	if has("created_at") {
+		out.CreatedAt = timestamppb.New(b.CreatedAt)
+	}
+	if has("members") {
+		out.Members = slice.Map(b.Members, func(m Member) *v1.BoardsResponse_Board_Member {
 			return m.toProto()
-		}),
+		})
+	}
+	if has("version") {
+		out.Version = b.Version
 	}
+	return out
 }
 
 func (m Member) toProto() *v1.BoardsResponse_Board_Member {
 	return &v1.BoardsResponse_Board_Member{
-		MemberId: m.MemberID,
-		Roles:    m.Roles,
+		MemberId:  m.MemberID,
+		Roles:     m.Roles,
+		Inherited: m.Inherited,
 	}
 }
 
 func CreateBoard(in *v1.CreateBoardRequest) Board {
 	return Board{
-		BoardID:   in.GetBoardId(),
-		OwnerID:   in.GetOwnerId(),
-		Name:      in.GetName(),
-		Metadata:  in.GetMetadata(),
-		CreatedAt: time.Now().UTC(),
+		BoardID:     in.GetBoardId(),
+		WorkspaceID: in.GetWorkspaceId(),
+		OwnerID:     in.GetOwnerId(),
+		Name:        in.GetName(),
+		Metadata:    in.GetMetadata(),
+		CreatedAt:   time.Now().UTC(),
 		Members: slice.Map(in.GetMembers(), func(item *v1.CreateBoardRequest_Member) Member {
 			return Member{
 				MemberID: item.GetMemberId(),
@@ -81,8 +173,10 @@ func UpdateBoard(in *v1.UpdateBoardRequest) Board {
 
 func CreateFilter(in *v1.BoardsRequest) Filter {
 	return Filter{
-		BoardIDs:  in.GetBoardIds(),
-		OwnerIDs:  in.GetOwnerIds(),
-		MemberIDs: in.GetMemberIds(),
+		BoardIDs:     in.GetBoardIds(),
+		WorkspaceIDs: in.GetWorkspaceIds(),
+		OwnerIDs:     in.GetOwnerIds(),
+		MemberIDs:    in.GetMemberIds(),
+		Fields:       in.GetReadMask().GetPaths(),
 	}
 }
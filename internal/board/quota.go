@@ -0,0 +1,66 @@
+package board
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-funcards/board-service/proto/v1"
+)
+
+// Limits caps what an owner may store, a zero value means unlimited.
+type Limits struct {
+	MaxBoards  uint64 `json:"max_boards" bson:"max_boards,omitempty" yaml:"max_boards"`
+	MaxMembers uint64 `json:"max_members" bson:"max_members,omitempty" yaml:"max_members"`
+}
+
+// Override returns l with the non-zero limits of o applied.
+func (l Limits) Override(o Limits) Limits {
+	if o.MaxBoards > 0 {
+		l.MaxBoards = o.MaxBoards
+	}
+	if o.MaxMembers > 0 {
+		l.MaxMembers = o.MaxMembers
+	}
+	return l
+}
+
+type Usage struct {
+	OwnerID             string `json:"owner_id" bson:"_id"`
+	Boards              uint64 `json:"boards" bson:"boards"`
+	Members             uint64 `json:"members" bson:"members"`
+	LargestBoardMembers uint64 `json:"largest_board_members" bson:"largest_board_members"`
+	Limits              Limits `json:"limits" bson:"-"`
+}
+
+func (u Usage) toProto() *v1.UsageResponse {
+	return &v1.UsageResponse{
+		OwnerId:             u.OwnerID,
+		Boards:              u.Boards,
+		BoardLimit:          u.Limits.MaxBoards,
+		Members:             u.Members,
+		LargestBoardMembers: u.LargestBoardMembers,
+		MemberLimit:         u.Limits.MaxMembers,
+	}
+}
+
+type QuotaStorage interface {
+	Limits(ctx context.Context, ownerID string) (Limits, error)
+	// Usage counts the boards of the owner, only the ones in the workspaces
+	// of the tenant of ctx when it has one. The limits are the owner's.
+	Usage(ctx context.Context, ownerID string) (Usage, error)
+	// SetDefaults replaces the limits of owners without overrides.
+	SetDefaults(defaults Limits)
+}
+
+func BoardQuotaExceeded(ownerID string, limit uint64) *Error {
+	return QuotaExceeded(ResourceOwner, ownerID, QuotaViolation{
+		Subject:     fmt.Sprintf("owner:%s", ownerID),
+		Description: fmt.Sprintf("owner may not have more than %d boards", limit),
+	})
+}
+
+func MemberQuotaExceeded(boardID string, limit uint64) *Error {
+	return QuotaExceeded(ResourceBoard, boardID, QuotaViolation{
+		Subject:     fmt.Sprintf("board:%s", boardID),
+		Description: fmt.Sprintf("board may not have more than %d members", limit),
+	})
+}
@@ -1,21 +1,37 @@
 package board
 
 import (
+	"bufio"
 	"context"
+	"errors"
+	"fmt"
 	"github.com/go-funcards/board-service/proto/v1"
 	"github.com/go-funcards/slice"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"io"
+	"strings"
 )
 
 var _ v1.BoardServer = (*server)(nil)
 
 type server struct {
 	v1.UnimplementedBoardServer
-	storage Storage
+	storage    Storage
+	workspaces WorkspaceStorage
+	audit      AuditStorage
+	versions   VersionStorage
+	quotas     QuotaStorage
+	rules      *ValidatorRef
 }
 
-func NewBoardServer(storage Storage) *server {
-	return &server{storage: storage}
+// NewBoardServer checks imported boards with the rules of rules, since they
+// do not arrive as requests the interceptors validate.
+func NewBoardServer(storage Storage, workspaces WorkspaceStorage, audit AuditStorage, versions VersionStorage, quotas QuotaStorage, rules *ValidatorRef) *server {
+	return &server{storage: storage, workspaces: workspaces, audit: audit, versions: versions, quotas: quotas, rules: rules}
 }
 
 func (s *server) CreateBoard(ctx context.Context, in *v1.CreateBoardRequest) (*emptypb.Empty, error) {
@@ -36,10 +52,64 @@ func (s *server) DeleteBoard(ctx context.Context, in *v1.DeleteBoardRequest) (*e
 	return s.empty(err)
 }
 
+func (s *server) BatchCreateBoards(ctx context.Context, in *v1.BatchCreateBoardsRequest) (*v1.BatchBoardsResponse, error) {
+	models := slice.Map(in.GetBoards(), CreateBoard)
+
+	errs, err := s.storage.SaveMany(ctx, models)
+
+	return s.batch(slice.Map(models, func(item Board) string {
+		return item.BoardID
+	}), errs, err)
+}
+
+func (s *server) BatchUpdateBoards(ctx context.Context, in *v1.BatchUpdateBoardsRequest) (*v1.BatchBoardsResponse, error) {
+	models := slice.Map(in.GetBoards(), UpdateBoard)
+
+	errs, err := s.storage.SaveMany(ctx, models)
+
+	return s.batch(slice.Map(models, func(item Board) string {
+		return item.BoardID
+	}), errs, err)
+}
+
+func (s *server) BatchDeleteBoards(ctx context.Context, in *v1.BatchDeleteBoardsRequest) (*v1.BatchBoardsResponse, error) {
+	errs, err := s.storage.DeleteMany(ctx, in.GetBoardIds())
+
+	return s.batch(in.GetBoardIds(), errs, err)
+}
+
+func (s *server) GetBoard(ctx context.Context, in *v1.BoardRequest) (*v1.BoardsResponse_Board, error) {
+	if err := s.validateReadMask(in.GetReadMask()); err != nil {
+		return nil, err
+	}
+
+	filter := Filter{
+		BoardIDs: []string{in.GetBoardId()},
+		Fields:   in.GetReadMask().GetPaths(),
+	}
+
+	data, err := s.find(ctx, filter, 0, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, NotFound(ResourceBoard, in.GetBoardId(), nil)
+	}
+
+	return data[0].toProto(filter.Fields), nil
+}
+
 func (s *server) GetBoards(ctx context.Context, in *v1.BoardsRequest) (*v1.BoardsResponse, error) {
-	filter := CreateFilter(in)
+	if err := s.validateReadMask(in.GetReadMask()); err != nil {
+		return nil, err
+	}
+
+	filter, err := s.memberWorkspaces(ctx, CreateFilter(in))
+	if err != nil {
+		return nil, err
+	}
 
-	data, err := s.storage.Find(ctx, filter, in.GetPageIndex(), in.GetPageSize())
+	data, err := s.find(ctx, filter, in.GetPageIndex(), in.GetPageSize())
 	if err != nil {
 		return nil, err
 	}
@@ -53,15 +123,354 @@ func (s *server) GetBoards(ctx context.Context, in *v1.BoardsRequest) (*v1.Board
 
 	return &v1.BoardsResponse{
 		Boards: slice.Map(data, func(item Board) *v1.BoardsResponse_Board {
+			return item.toProto(filter.Fields)
+		}),
+		Total: total,
+	}, nil
+}
+
+func (s *server) ListBoardAudit(ctx context.Context, in *v1.BoardAuditRequest) (*v1.BoardAuditResponse, error) {
+	filter, err := CreateAuditFilter(in)
+	if err != nil {
+		return nil, InvalidArgument(FieldViolation{Field: "page_token", Description: err.Error()})
+	}
+	filter.WorkspaceIDs, _ = scope(ctx, nil)
+
+	data, err := s.audit.Find(ctx, filter, in.GetPageSize()+1)
+	if err != nil {
+		return nil, err
+	}
+
+	var next string
+	if uint32(len(data)) > in.GetPageSize() {
+		data = data[:in.GetPageSize()]
+		if len(data) > 0 {
+			next = encodePageToken(data[len(data)-1].AuditID)
+		}
+	}
+
+	return &v1.BoardAuditResponse{
+		Entries: slice.Map(data, func(item AuditEntry) *v1.BoardAuditResponse_Entry {
+			return item.toProto()
+		}),
+		NextPageToken: next,
+	}, nil
+}
+
+func (s *server) ListBoardVersions(ctx context.Context, in *v1.BoardVersionsRequest) (*v1.BoardVersionsResponse, error) {
+	if err := s.authorize(ctx, in.GetBoardId()); err != nil {
+		return nil, err
+	}
+
+	data, err := s.versions.Find(ctx, in.GetBoardId(), in.GetPageIndex(), in.GetPageSize())
+	if err != nil {
+		return nil, err
+	}
+
+	total := uint64(len(data))
+	if uint64(in.GetPageSize()) == total {
+		if total, err = s.versions.Count(ctx, in.GetBoardId()); err != nil {
+			return nil, err
+		}
+	}
+
+	return &v1.BoardVersionsResponse{
+		Versions: slice.Map(data, func(item BoardVersion) *v1.BoardVersionsResponse_Version {
+			return item.toProto()
+		}),
+		Total: total,
+	}, nil
+}
+
+func (s *server) GetBoardVersion(ctx context.Context, in *v1.BoardVersionRequest) (*v1.BoardVersionsResponse_Version, error) {
+	if err := s.authorize(ctx, in.GetBoardId()); err != nil {
+		return nil, err
+	}
+
+	version, err := s.versions.FindOne(ctx, in.GetBoardId(), in.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+	return version.toProto(), nil
+}
+
+func (s *server) RevertBoard(ctx context.Context, in *v1.RevertBoardRequest) (*emptypb.Empty, error) {
+	if err := s.authorize(ctx, in.GetBoardId()); err != nil {
+		return nil, err
+	}
+
+	version, err := s.versions.FindOne(ctx, in.GetBoardId(), in.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := s.storage.Find(ctx, Filter{BoardIDs: []string{in.GetBoardId()}}, 0, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		// a deleted board comes back as it was in the version
+		err = s.storage.Save(ctx, RestoreBoard(version))
+	} else {
+		err = s.storage.Save(ctx, RevertBoard(data[0], version))
+	}
+
+	return s.empty(err)
+}
+
+func (s *server) TransferBoard(ctx context.Context, in *v1.TransferBoardRequest) (*emptypb.Empty, error) {
+	return s.empty(s.storage.Transfer(ctx, in.GetBoardId(), in.GetOwnerId()))
+}
+
+func (s *server) MoveBoard(ctx context.Context, in *v1.MoveBoardRequest) (*emptypb.Empty, error) {
+	if !Allowed(ctx, in.GetToWorkspaceId()) {
+		return nil, PermissionDenied(ResourceWorkspace, in.GetToWorkspaceId(), "workspace is not available to the caller")
+	}
+	if _, err := s.workspace(ctx, in.GetToWorkspaceId()); err != nil {
+		return nil, err
+	}
+
+	return s.empty(s.storage.Move(ctx, in.GetBoardId(), in.GetToWorkspaceId()))
+}
+
+func (s *server) GetUsage(ctx context.Context, in *v1.UsageRequest) (*v1.UsageResponse, error) {
+	usage, err := s.quotas.Usage(ctx, in.GetOwnerId())
+	if err != nil {
+		return nil, err
+	}
+	return usage.toProto(), nil
+}
+
+func (s *server) ExportBoards(in *v1.ExportBoardsRequest, stream v1.Board_ExportBoardsServer) error {
+	w := bufio.NewWriterSize(chunkWriter{stream: stream}, exportChunkSize)
+	if err := ExportBoards(stream.Context(), s.storage, CreateExportFilter(in), w); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+func (s *server) ImportBoards(stream v1.Board_ImportBoardsServer) error {
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return InvalidArgument(FieldViolation{Field: "data", Description: "import is empty"})
+	}
+	if err != nil {
+		return err
+	}
+
+	r := &chunkReader{stream: stream, data: first.GetData()}
+	res, err := ImportBoards(stream.Context(), s.storage, s.rules, first.GetMode(), first.GetRemapIds(), first.GetWorkspaceId(), r)
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(res)
+}
+
+func (s *server) CreateWorkspace(ctx context.Context, in *v1.CreateWorkspaceRequest) (*emptypb.Empty, error) {
+	return s.empty(s.workspaces.Create(ctx, CreateWorkspace(in)))
+}
+
+func (s *server) UpdateWorkspace(ctx context.Context, in *v1.UpdateWorkspaceRequest) (*emptypb.Empty, error) {
+	return s.empty(s.workspaces.Update(ctx, UpdateWorkspace(in)))
+}
+
+// DeleteWorkspace refuses to delete a workspace that still has boards, they
+// would be left in a workspace nobody manages. Boards have to be moved or
+// deleted first; the storage checks it with the delete.
+func (s *server) DeleteWorkspace(ctx context.Context, in *v1.DeleteWorkspaceRequest) (*emptypb.Empty, error) {
+	return s.empty(s.workspaces.Delete(ctx, in.GetWorkspaceId()))
+}
+
+func (s *server) GetWorkspace(ctx context.Context, in *v1.WorkspaceRequest) (*v1.WorkspacesResponse_Workspace, error) {
+	w, err := s.workspace(ctx, in.GetWorkspaceId())
+	if err != nil {
+		return nil, err
+	}
+	return w.toProto(), nil
+}
+
+func (s *server) GetWorkspaces(ctx context.Context, in *v1.WorkspacesRequest) (*v1.WorkspacesResponse, error) {
+	filter := CreateWorkspaceFilter(in)
+
+	var ok bool
+	if filter.WorkspaceIDs, ok = scope(ctx, filter.WorkspaceIDs); !ok {
+		return &v1.WorkspacesResponse{}, nil
+	}
+
+	data, err := s.workspaces.Find(ctx, filter, in.GetPageIndex(), in.GetPageSize())
+	if err != nil {
+		return nil, err
+	}
+
+	total := uint64(len(data))
+	if uint64(in.GetPageSize()) == total {
+		if total, err = s.workspaces.Count(ctx, filter); err != nil {
+			return nil, err
+		}
+	}
+
+	return &v1.WorkspacesResponse{
+		Workspaces: slice.Map(data, func(item Workspace) *v1.WorkspacesResponse_Workspace {
 			return item.toProto()
 		}),
 		Total: total,
 	}, nil
 }
 
+// workspace loads the workspace id, which the tenant interceptor already
+// checked the caller may act in.
+func (s *server) workspace(ctx context.Context, id string) (Workspace, error) {
+	data, err := s.workspaces.Find(ctx, WorkspaceFilter{WorkspaceIDs: []string{id}}, 0, 1)
+	if err != nil {
+		return Workspace{}, err
+	}
+	if len(data) == 0 {
+		return Workspace{}, NotFound(ResourceWorkspace, id, nil)
+	}
+	return data[0], nil
+}
+
+// find loads the boards matching filter with the members they inherit from
+// their workspaces. Those are not stored with the boards, so they are only
+// added to what is read by the API: exports and the audit log leave them out.
+func (s *server) find(ctx context.Context, filter Filter, index uint64, size uint32) ([]Board, error) {
+	query := filter
+	if len(filter.Fields) > 0 && slice.Contains(filter.Fields, "members") && !slice.Contains(filter.Fields, "workspace_id") {
+		query.Fields = append(slice.Copy(filter.Fields), "workspace_id")
+	}
+
+	data, err := s.storage.Find(ctx, query, index, size)
+	if err != nil || len(data) == 0 || (len(filter.Fields) > 0 && !slice.Contains(filter.Fields, "members")) {
+		return data, err
+	}
+
+	var ids []string
+	for _, item := range data {
+		if len(item.WorkspaceID) > 0 && !slice.Contains(ids, item.WorkspaceID) {
+			ids = append(ids, item.WorkspaceID)
+		}
+	}
+	if len(ids) == 0 {
+		return data, nil
+	}
+
+	workspaces, err := s.workspaces.Find(ctx, WorkspaceFilter{WorkspaceIDs: ids}, 0, uint32(len(ids)))
+	if err != nil {
+		return nil, err
+	}
+	for _, w := range workspaces {
+		for i := range data {
+			if data[i].WorkspaceID == w.WorkspaceID {
+				data[i] = data[i].inherit(w)
+			}
+		}
+	}
+	return data, nil
+}
+
+// memberWorkspaces extends a lookup by members to the boards of the
+// workspaces they are members of, within the workspaces of the caller.
+func (s *server) memberWorkspaces(ctx context.Context, filter Filter) (Filter, error) {
+	if len(filter.MemberIDs) == 0 {
+		return filter, nil
+	}
+	ids, ok := scope(ctx, filter.WorkspaceIDs)
+	if !ok {
+		return filter, nil
+	}
+
+	workspaces, err := s.workspaces.Find(ctx, WorkspaceFilter{WorkspaceIDs: ids, MemberIDs: filter.MemberIDs}, 0, 0)
+	if err != nil {
+		return filter, err
+	}
+	filter.MemberWorkspaceIDs = slice.Map(workspaces, func(item Workspace) string {
+		return item.WorkspaceID
+	})
+	return filter, nil
+}
+
+// authorize checks that the board, or the deleted board versions are kept
+// for, is in the workspaces of the tenant of ctx. Versions are not scoped by
+// a storage, so boards of other workspaces are not found, as in Storage.
+func (s *server) authorize(ctx context.Context, boardID string) error {
+	if _, ok := TenantFromContext(ctx); !ok {
+		return nil
+	}
+
+	data, err := s.storage.Find(ctx, Filter{BoardIDs: []string{boardID}}, 0, 1)
+	if err != nil || len(data) > 0 {
+		return err
+	}
+
+	// a deleted board stays in the workspace of its latest version
+	versions, err := s.versions.Find(ctx, boardID, 0, 1)
+	if err != nil {
+		return err
+	}
+	if len(versions) == 0 || !Allowed(ctx, versions[0].Board.WorkspaceID) {
+		return NotFound(ResourceBoard, boardID, nil)
+	}
+	return nil
+}
+
+// validateReadMask accepts top-level fields of a board only: storages project
+// and responses are built by field name, members come whole or not at all.
+func (s *server) validateReadMask(mask *fieldmaskpb.FieldMask) error {
+	if mask != nil && !mask.IsValid(&v1.BoardsResponse_Board{}) {
+		return InvalidArgument(FieldViolation{
+			Field:       "read_mask",
+			Description: fmt.Sprintf("unknown paths in %v", mask.GetPaths()),
+		})
+	}
+	for _, path := range mask.GetPaths() {
+		if strings.Contains(path, ".") {
+			return InvalidArgument(FieldViolation{
+				Field:       "read_mask",
+				Description: fmt.Sprintf("nested path %s is not supported, use %s", path, strings.SplitN(path, ".", 2)[0]),
+			})
+		}
+	}
+	return nil
+}
+
 func (s *server) empty(err error) (*emptypb.Empty, error) {
 	if err != nil {
 		return nil, err
 	}
 	return &emptypb.Empty{}, nil
 }
+
+func (s *server) batch(ids []string, errs []error, err error) (*v1.BatchBoardsResponse, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*v1.BatchBoardsResponse_Result, len(ids))
+	for i, id := range ids {
+		st := status.New(codes.OK, "")
+		if errs[i] != nil {
+			st = status.New(batchCode(errs[i]), errs[i].Error())
+		}
+		results[i] = &v1.BatchBoardsResponse_Result{
+			BoardId: id,
+			Code:    uint32(st.Code()),
+			Message: st.Message(),
+		}
+	}
+	return &v1.BatchBoardsResponse{Results: results}, nil
+}
+
+func batchCode(err error) codes.Code {
+	var e *Error
+	switch {
+	case errors.As(err, &e):
+		return e.Code
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return codes.NotFound
+	case mongo.IsDuplicateKeyError(err):
+		return codes.AlreadyExists
+	case mongo.IsTimeout(err):
+		return codes.DeadlineExceeded
+	}
+	return codes.Internal
+}
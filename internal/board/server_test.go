@@ -0,0 +1,61 @@
+package board
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-funcards/board-service/proto/v1"
+	"google.golang.org/grpc/codes"
+	"testing"
+)
+
+// batchStorage fails the items of a batch with errs, whatever they are.
+type batchStorage struct {
+	Storage
+	errs []error
+	err  error
+}
+
+func (s *batchStorage) SaveMany(_ context.Context, models []Board) ([]error, error) {
+	return s.errs[:len(models)], s.err
+}
+
+func (s *batchStorage) DeleteMany(_ context.Context, ids []string) ([]error, error) {
+	return s.errs[:len(ids)], s.err
+}
+
+func TestBatchReportsEveryItem(t *testing.T) {
+	storage := &batchStorage{errs: []error{
+		nil,
+		NotFound(ResourceWorkspace, "missing", nil),
+		fmt.Errorf("save b3: %w", Conflict(ResourceBoard, "b3", "board changed during the write")),
+		errors.New("connection reset"),
+	}}
+	s := NewBoardServer(storage, nil, nil, nil, nil, nil)
+
+	res, err := s.BatchCreateBoards(context.Background(), &v1.BatchCreateBoardsRequest{Boards: []*v1.CreateBoardRequest{
+		{BoardId: "b1"}, {BoardId: "b2"}, {BoardId: "b3"}, {BoardId: "b4"},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []codes.Code{codes.OK, codes.NotFound, codes.Aborted, codes.Internal}
+	if len(res.GetResults()) != len(want) {
+		t.Fatalf("got %d results, want one per item", len(res.GetResults()))
+	}
+	for i, r := range res.GetResults() {
+		if id := fmt.Sprintf("b%d", i+1); r.GetBoardId() != id || codes.Code(r.GetCode()) != want[i] {
+			t.Fatalf("result %d: got %s with %v, want %s with %v", i, r.GetBoardId(), codes.Code(r.GetCode()), id, want[i])
+		}
+		if (r.GetMessage() == "") != (want[i] == codes.OK) {
+			t.Fatalf("result %d: got message %q", i, r.GetMessage())
+		}
+	}
+
+	// a batch which was not written at all fails as a whole
+	storage.err = errors.New("unavailable")
+	if _, err = s.BatchDeleteBoards(context.Background(), &v1.BatchDeleteBoardsRequest{BoardIds: []string{"b1"}}); err == nil {
+		t.Fatal("got no error, want the batch to fail")
+	}
+}
@@ -0,0 +1,102 @@
+package board
+
+import (
+	"context"
+	"github.com/go-funcards/board-service/internal/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rs/zerolog"
+	"sync"
+	"time"
+)
+
+// Stats counts the boards of a storage and their members.
+type Stats struct {
+	Boards  uint64
+	Members uint64
+}
+
+// StatsStorage is implemented by the storages able to count their boards.
+type StatsStorage interface {
+	Stats(ctx context.Context) (Stats, error)
+}
+
+var _ prometheus.Collector = (*statsCollector)(nil)
+
+// statsCollector reports the number of boards and board members. Counting
+// reads every board, so it is done every interval in the background rather
+// than on every scrape, which reports the latest counts.
+type statsCollector struct {
+	storage StatsStorage
+	log     zerolog.Logger
+	boards  *prometheus.Desc
+	members *prometheus.Desc
+
+	mu        sync.RWMutex
+	stats     Stats
+	collected bool
+}
+
+// NewStatsCollector counts the boards of storage every interval until ctx is
+// done, and right away.
+func NewStatsCollector(ctx context.Context, storage StatsStorage, interval time.Duration, log zerolog.Logger) *statsCollector {
+	c := &statsCollector{
+		storage: storage,
+		log:     log.With().Str("collector", "stats").Logger(),
+		boards: prometheus.NewDesc(
+			prometheus.BuildFQName(metrics.Namespace, "", "boards"),
+			"Total number of boards.",
+			nil, nil,
+		),
+		members: prometheus.NewDesc(
+			prometheus.BuildFQName(metrics.Namespace, "", "board_members"),
+			"Total number of board members.",
+			nil, nil,
+		),
+	}
+
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	go c.refresh(ctx, interval)
+
+	return c
+}
+
+func (c *statsCollector) refresh(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		stats, err := c.storage.Stats(ctx)
+		if err != nil {
+			c.log.Error().Err(err).Msg("stats not collected")
+		} else {
+			c.mu.Lock()
+			c.stats, c.collected = stats, true
+			c.mu.Unlock()
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *statsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.boards
+	ch <- c.members
+}
+
+func (c *statsCollector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if !c.collected {
+		return
+	}
+
+	ch <- prometheus.MustNewConstMetric(c.boards, prometheus.GaugeValue, float64(c.stats.Boards))
+	ch <- prometheus.MustNewConstMetric(c.members, prometheus.GaugeValue, float64(c.stats.Members))
+}
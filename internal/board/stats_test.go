@@ -0,0 +1,67 @@
+package board
+
+import (
+	"context"
+	"errors"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/rs/zerolog"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// countingStorage returns its stats, or fails until they are set.
+type countingStorage struct {
+	mu    sync.Mutex
+	stats *Stats
+}
+
+func (s *countingStorage) Stats(context.Context) (Stats, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.stats == nil {
+		return Stats{}, errors.New("unavailable")
+	}
+	return *s.stats, nil
+}
+
+func (s *countingStorage) set(stats Stats) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stats = &stats
+}
+
+func TestStatsCollectorReportsTheLatestCounts(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	storage := new(countingStorage)
+	c := NewStatsCollector(ctx, storage, time.Millisecond, zerolog.Nop())
+
+	// nothing is reported before the boards were counted once
+	if n := testutil.CollectAndCount(c); n != 0 {
+		t.Fatalf("got %d metrics, want none before counting", n)
+	}
+
+	storage.set(Stats{Boards: 3, Members: 7})
+	deadline := time.Now().Add(5 * time.Second)
+	for testutil.CollectAndCount(c) == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("stats never collected")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	want := `
+# HELP board_service_board_members Total number of board members.
+# TYPE board_service_board_members gauge
+board_service_board_members 7
+# HELP board_service_boards Total number of boards.
+# TYPE board_service_boards gauge
+board_service_boards 3
+`
+	if err := testutil.CollectAndCompare(c, strings.NewReader(want)); err != nil {
+		t.Fatal(err)
+	}
+}
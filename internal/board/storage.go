@@ -3,8 +3,41 @@ package board
 import "context"
 
 type Storage interface {
+	// Save creates or updates the board. A new board gets version
+	// model.Version+1, so that a board created again continues the history
+	// of the deleted one; the version of existing boards is incremented.
 	Save(ctx context.Context, model Board) error
+	SaveMany(ctx context.Context, models []Board) ([]error, error)
 	Delete(ctx context.Context, id string) error
+	DeleteMany(ctx context.Context, ids []string) ([]error, error)
+	// Transfer makes ownerID the owner of the board, within the limits of the
+	// new owner. Transferring a board to its owner changes nothing.
+	Transfer(ctx context.Context, id, ownerID string) error
+	// Move puts the board in the workspace workspaceID. Moving a board to its
+	// workspace changes nothing.
+	Move(ctx context.Context, id, workspaceID string) error
+	// Restore inserts boards exactly as given, owner, creation time and
+	// version included, to reload a backup. Boards that exist fail with
+	// AlreadyExists; limits are not checked.
+	Restore(ctx context.Context, models []Board) ([]error, error)
 	Find(ctx context.Context, filter Filter, index uint64, size uint32) ([]Board, error)
 	Count(ctx context.Context, filter Filter) (uint64, error)
 }
+
+type AuditStorage interface {
+	Append(ctx context.Context, entries ...AuditEntry) error
+	Find(ctx context.Context, filter AuditFilter, size uint32) ([]AuditEntry, error)
+	// Purge deletes every entry of the boards. It undoes a failed restore.
+	Purge(ctx context.Context, boardIDs ...string) error
+}
+
+type VersionStorage interface {
+	// Append stores snapshots. Versions that were already recorded are left
+	// as they are and reported with AlreadyExists, the others are stored.
+	Append(ctx context.Context, versions ...BoardVersion) error
+	FindOne(ctx context.Context, boardID string, version uint64) (BoardVersion, error)
+	Find(ctx context.Context, boardID string, index uint64, size uint32) ([]BoardVersion, error)
+	Count(ctx context.Context, boardID string) (uint64, error)
+	// Purge deletes every version of the boards. It undoes a failed restore.
+	Purge(ctx context.Context, boardIDs ...string) error
+}
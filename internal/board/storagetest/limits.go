@@ -0,0 +1,165 @@
+package storagetest
+
+import (
+	"context"
+	"github.com/go-funcards/board-service/internal/board"
+	"google.golang.org/grpc/codes"
+	"testing"
+)
+
+// RunLimits runs the contract of the board and member limits against the
+// storages returned by open, with their quotas defaulting to limits. They
+// must be empty and not shared with the other tests.
+func RunLimits(t *testing.T, open func(t *testing.T, limits board.Limits) Storages) {
+	tests := []struct {
+		name   string
+		limits board.Limits
+		fn     func(t *testing.T, s board.Storage)
+	}{
+		{"BoardLimit", board.Limits{MaxBoards: 2}, testBoardLimit},
+		{"BoardLimitSaveMany", board.Limits{MaxBoards: 2}, testBoardLimitSaveMany},
+		{"BoardLimitTransfer", board.Limits{MaxBoards: 1}, testBoardLimitTransfer},
+		{"MemberLimit", board.Limits{MaxMembers: 2}, testMemberLimit},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			s := open(t, tt.limits)
+			createWorkspaces(t, s.Workspaces, "w1", "w2")
+			tt.fn(t, s.Boards)
+		})
+	}
+	t.Run("UsageOfTenant", func(t *testing.T) {
+		s := open(t, board.Limits{MaxBoards: 10})
+		createWorkspaces(t, s.Workspaces, "w1", "w2")
+		testUsageOfTenant(t, s.Boards, s.Quotas)
+	})
+}
+
+func testUsageOfTenant(t *testing.T, s board.Storage, quotas board.QuotaStorage) {
+	save(t, s,
+		newBoard("b1", "w1", "o1", 0, "m1"),
+		newBoard("b2", "w2", "o1", 1, "m1", "m2", "m3"),
+		newBoard("b3", "w1", "o2", 2, "m1"),
+	)
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		boards  uint64
+		members uint64
+		largest uint64
+	}{
+		{"no tenant", context.Background(), 2, 4, 3},
+		{"tenant", board.WithTenant(context.Background(), board.Tenant{WorkspaceIDs: []string{"w1"}}), 1, 1, 1},
+		{"other tenant", board.WithTenant(context.Background(), board.Tenant{WorkspaceIDs: []string{"w3"}}), 0, 0, 0},
+	}
+	for _, tt := range tests {
+		usage, err := quotas.Usage(tt.ctx, "o1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if usage.Boards != tt.boards || usage.Members != tt.members || usage.LargestBoardMembers != tt.largest {
+			t.Fatalf("%s: got %+v, want %d boards, %d members, at most %d", tt.name, usage, tt.boards, tt.members, tt.largest)
+		}
+		if usage.Limits.MaxBoards != 10 {
+			t.Fatalf("%s: got limits %+v, want the owner's", tt.name, usage.Limits)
+		}
+	}
+}
+
+func testBoardLimit(t *testing.T, s board.Storage) {
+	save(t, s, newBoard("b1", "w1", "o1", 0), newBoard("b2", "w1", "o1", 1))
+
+	expectCode(t, s.Save(context.Background(), newBoard("b3", "w1", "o1", 2)), codes.ResourceExhausted)
+	if n, err := s.Count(context.Background(), board.Filter{OwnerIDs: []string{"o1"}}); err != nil || n != 2 {
+		t.Fatalf("got %d boards (%v), want the third one not written", n, err)
+	}
+
+	// updates do not count, other owners have limits of their own
+	save(t, s, board.Board{BoardID: "b1", Name: "renamed"}, newBoard("b3", "w1", "o2", 2))
+
+	// a deleted board frees its slot
+	if err := s.Delete(context.Background(), "b1"); err != nil {
+		t.Fatal(err)
+	}
+	save(t, s, newBoard("b4", "w1", "o1", 3))
+}
+
+func testBoardLimitSaveMany(t *testing.T, s board.Storage) {
+	// the repeated board is only new once, and counted once
+	errs, err := s.SaveMany(context.Background(), []board.Board{
+		newBoard("b1", "w1", "o1", 0),
+		newBoard("b1", "w1", "o1", 0),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, err := range errs {
+		if err != nil {
+			t.Fatalf("item %d: %v", i, err)
+		}
+	}
+
+	errs, err = s.SaveMany(context.Background(), []board.Board{
+		newBoard("b2", "w1", "o1", 1),
+		newBoard("b3", "w1", "o1", 2),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if errs[0] != nil {
+		t.Fatalf("item 0: %v", errs[0])
+	}
+	expectCode(t, errs[1], codes.ResourceExhausted)
+
+	if got := sorted(ids(find(t, s, board.Filter{OwnerIDs: []string{"o1"}}))); !equal(got, []string{"b1", "b2"}) {
+		t.Fatalf("got %v, want [b1 b2]", got)
+	}
+}
+
+func testBoardLimitTransfer(t *testing.T, s board.Storage) {
+	save(t, s, newBoard("b1", "w1", "o1", 0), newBoard("b2", "w1", "o2", 1))
+
+	expectCode(t, s.Transfer(context.Background(), "b2", "o1"), codes.ResourceExhausted)
+
+	replace := newBoard("b2", "w1", "o1", 1)
+	replace.Replace = true
+	expectCode(t, s.Save(context.Background(), replace), codes.ResourceExhausted)
+
+	if got := get(t, s, "b2"); got.OwnerID != "o2" {
+		t.Fatalf("got owner %q, want o2", got.OwnerID)
+	}
+
+	// once o1 has room again
+	if err := s.Delete(context.Background(), "b1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Transfer(context.Background(), "b2", "o1"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testMemberLimit(t *testing.T, s board.Storage) {
+	expectCode(t, s.Save(context.Background(), newBoard("b1", "w1", "o1", 0, "m1", "m2", "m3")), codes.ResourceExhausted)
+	if n, err := s.Count(context.Background(), board.Filter{}); err != nil || n != 0 {
+		t.Fatalf("got %d boards (%v), want none written", n, err)
+	}
+
+	save(t, s, newBoard("b1", "w1", "o1", 0, "m1", "m2"))
+
+	err := s.Save(context.Background(), board.Board{BoardID: "b1", Members: []board.Member{{MemberID: "m3", Roles: []string{"viewer"}}}})
+	expectCode(t, err, codes.ResourceExhausted)
+	if got := members(get(t, s, "b1")); !equal(got, []string{"m1", "m2"}) {
+		t.Fatalf("got members %v, want [m1 m2]", got)
+	}
+
+	// swapping a member keeps the board within the limit
+	save(t, s, board.Board{BoardID: "b1", Members: []board.Member{
+		{MemberID: "m1", Delete: true},
+		{MemberID: "m3", Roles: []string{"viewer"}},
+	}})
+	if got := members(get(t, s, "b1")); !equal(got, []string{"m2", "m3"}) {
+		t.Fatalf("got members %v, want [m2 m3]", got)
+	}
+}
@@ -0,0 +1,772 @@
+// Package storagetest holds the behaviour every board.Storage driver has to
+// share, run against each of them by their tests.
+package storagetest
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-funcards/board-service/internal/board"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Storages are the storages of a driver sharing one database.
+type Storages struct {
+	Boards     board.Storage
+	Workspaces board.WorkspaceStorage
+	Quotas     board.QuotaStorage
+}
+
+// Run runs the contract against the storages returned by open, which must be
+// empty and not shared with the other tests. The workspaces the boards are
+// written to are created first.
+func Run(t *testing.T, open func(t *testing.T) Storages) {
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, s board.Storage)
+	}{
+		{"SaveCreates", testSaveCreates},
+		{"SaveUpdates", testSaveUpdates},
+		{"SaveContinuesVersion", testSaveContinuesVersion},
+		{"SaveReplaces", testSaveReplaces},
+		{"SaveMany", testSaveMany},
+		{"SaveManyPartialFailure", testSaveManyPartialFailure},
+		{"FindByBoardIDs", testFindByBoardIDs},
+		{"FindByWorkspaceOwnerMember", testFindByWorkspaceOwnerMember},
+		{"FindPages", testFindPages},
+		{"FindPagesAcrossIndexes", testFindPagesAcrossIndexes},
+		{"FindFields", testFindFields},
+		{"Delete", testDelete},
+		{"DeleteMany", testDeleteMany},
+		{"Transfer", testTransfer},
+		{"Move", testMove},
+		{"Restore", testRestore},
+		{"RecordsChanges", testRecordsChanges},
+		{"TenantIsolation", testTenantIsolation},
+		{"TenantWrites", testTenantWrites},
+		{"WorkspaceRequired", testWorkspaceRequired},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			s := open(t)
+			createWorkspaces(t, s.Workspaces, "w1", "w2", "w3")
+			tt.fn(t, s.Boards)
+		})
+	}
+}
+
+var created = time.Date(2022, 7, 1, 12, 0, 0, 0, time.UTC)
+
+func newBoard(id, workspaceID, ownerID string, offset int, members ...string) board.Board {
+	b := board.Board{
+		BoardID:     id,
+		WorkspaceID: workspaceID,
+		OwnerID:     ownerID,
+		Name:        "board " + id,
+		Metadata:    `{"id":"` + id + `"}`,
+		CreatedAt:   created.Add(time.Duration(offset) * time.Minute),
+	}
+	for _, m := range members {
+		b.Members = append(b.Members, board.Member{MemberID: m, Roles: []string{"editor"}})
+	}
+	return b
+}
+
+func createWorkspaces(t *testing.T, ws board.WorkspaceStorage, ids ...string) {
+	t.Helper()
+	for _, id := range ids {
+		if err := ws.Create(context.Background(), newWorkspace(id, "o1")); err != nil {
+			t.Fatalf("create workspace %s: %v", id, err)
+		}
+	}
+}
+
+func save(t *testing.T, s board.Storage, boards ...board.Board) {
+	t.Helper()
+	for _, b := range boards {
+		if err := s.Save(context.Background(), b); err != nil {
+			t.Fatalf("save %s: %v", b.BoardID, err)
+		}
+	}
+}
+
+func find(t *testing.T, s board.Storage, filter board.Filter) []board.Board {
+	t.Helper()
+	data, err := s.Find(context.Background(), filter, 0, 100)
+	if err != nil {
+		t.Fatalf("find %+v: %v", filter, err)
+	}
+	return data
+}
+
+func get(t *testing.T, s board.Storage, id string) board.Board {
+	t.Helper()
+	data := find(t, s, board.Filter{BoardIDs: []string{id}})
+	if len(data) != 1 {
+		t.Fatalf("find %s: got %d boards, want 1", id, len(data))
+	}
+	return data[0]
+}
+
+func ids(boards []board.Board) []string {
+	out := make([]string, 0, len(boards))
+	for _, b := range boards {
+		out = append(out, b.BoardID)
+	}
+	return out
+}
+
+func sorted(values []string) []string {
+	out := append([]string(nil), values...)
+	sort.Strings(out)
+	return out
+}
+
+func members(b board.Board) []string {
+	out := make([]string, 0, len(b.Members))
+	for _, m := range b.Members {
+		out = append(out, m.MemberID)
+	}
+	return sorted(out)
+}
+
+func equal(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func expectCode(t *testing.T, err error, code codes.Code) {
+	t.Helper()
+	if got := status.Code(err); got != code {
+		t.Fatalf("got %v (%v), want %v", got, err, code)
+	}
+}
+
+func testSaveCreates(t *testing.T, s board.Storage) {
+	want := newBoard("b1", "w1", "o1", 0, "m1", "m2")
+	save(t, s, want)
+
+	got := get(t, s, "b1")
+	if got.WorkspaceID != want.WorkspaceID || got.OwnerID != want.OwnerID || got.Name != want.Name || got.Metadata != want.Metadata {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+	if !got.CreatedAt.Equal(want.CreatedAt) {
+		t.Fatalf("created at %v, want %v", got.CreatedAt, want.CreatedAt)
+	}
+	if got.Version != 1 {
+		t.Fatalf("version %d, want 1", got.Version)
+	}
+	if m := members(got); !equal(m, []string{"m1", "m2"}) {
+		t.Fatalf("members %v, want [m1 m2]", m)
+	}
+}
+
+func testSaveUpdates(t *testing.T, s board.Storage) {
+	save(t, s, newBoard("b1", "w1", "o1", 0, "m1", "m2"))
+
+	update := board.Board{
+		BoardID:   "b1",
+		OwnerID:   "o2",
+		Name:      "renamed",
+		CreatedAt: created.Add(time.Hour),
+		Members: []board.Member{
+			{MemberID: "m1", Delete: true},
+			{MemberID: "m3", Roles: []string{"viewer"}},
+		},
+	}
+	save(t, s, update)
+
+	got := get(t, s, "b1")
+	if got.Name != "renamed" {
+		t.Fatalf("name %q, want renamed", got.Name)
+	}
+	if got.OwnerID != "o1" || !got.CreatedAt.Equal(created) || got.WorkspaceID != "w1" {
+		t.Fatalf("owner, creation time and workspace changed: %+v", got)
+	}
+	if got.Metadata != `{"id":"b1"}` {
+		t.Fatalf("metadata %q, want it kept", got.Metadata)
+	}
+	if got.Version != 2 {
+		t.Fatalf("version %d, want 2", got.Version)
+	}
+	if m := members(got); !equal(m, []string{"m2", "m3"}) {
+		t.Fatalf("members %v, want [m2 m3]", m)
+	}
+}
+
+func testSaveContinuesVersion(t *testing.T, s board.Storage) {
+	b := newBoard("b1", "w1", "o1", 0)
+	b.Version = 5
+	save(t, s, b)
+
+	if got := get(t, s, "b1"); got.Version != 6 {
+		t.Fatalf("version %d, want 6", got.Version)
+	}
+}
+
+func testSaveReplaces(t *testing.T, s board.Storage) {
+	save(t, s, newBoard("b1", "w1", "o1", 0, "m1", "m2"))
+
+	save(t, s, board.Board{
+		BoardID:     "b1",
+		WorkspaceID: "w2",
+		OwnerID:     "o2",
+		CreatedAt:   created.Add(time.Hour),
+		Members:     []board.Member{{MemberID: "m3", Roles: []string{"viewer"}}},
+		Replace:     true,
+	})
+
+	got := get(t, s, "b1")
+	if got.OwnerID != "o2" || got.Name != "" || got.Metadata != "" {
+		t.Fatalf("got %+v, want owner o2 with name and metadata cleared", got)
+	}
+	if got.WorkspaceID != "w1" || !got.CreatedAt.Equal(created) {
+		t.Fatalf("workspace and creation time changed: %+v", got)
+	}
+	if got.Version != 2 {
+		t.Fatalf("version %d, want 2", got.Version)
+	}
+	if m := members(got); !equal(m, []string{"m3"}) {
+		t.Fatalf("members %v, want [m3]", m)
+	}
+	if found := find(t, s, board.Filter{OwnerIDs: []string{"o1"}}); len(found) != 0 {
+		t.Fatalf("still found by the old owner: %v", ids(found))
+	}
+}
+
+func testSaveMany(t *testing.T, s board.Storage) {
+	save(t, s, newBoard("b1", "w1", "o1", 0))
+
+	errs, err := s.SaveMany(context.Background(), []board.Board{
+		{BoardID: "b1", Name: "renamed"},
+		newBoard("b2", "w1", "o1", 1),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, err := range errs {
+		if err != nil {
+			t.Fatalf("item %d: %v", i, err)
+		}
+	}
+
+	if got := get(t, s, "b1"); got.Name != "renamed" || got.Version != 2 {
+		t.Fatalf("got %+v, want b1 renamed at version 2", got)
+	}
+	if got := get(t, s, "b2"); got.Version != 1 {
+		t.Fatalf("version %d, want 1", got.Version)
+	}
+}
+
+func testSaveManyPartialFailure(t *testing.T, s board.Storage) {
+	save(t, s, newBoard("b1", "w1", "o1", 0), newBoard("b2", "w2", "o1", 1))
+
+	// failed items leave the board as it was and the others are written
+	ctx := board.WithTenant(context.Background(), board.Tenant{WorkspaceIDs: []string{"w1"}})
+	errs, err := s.SaveMany(ctx, []board.Board{
+		{BoardID: "b1", Name: "renamed"},
+		{BoardID: "b2", Name: "renamed"},
+		newBoard("b3", "missing", "o1", 2),
+		newBoard("b4", "w1", "o1", 3),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(errs) != 4 || errs[0] != nil || errs[3] != nil {
+		t.Fatalf("got %v, want only b2 and b3 to fail", errs)
+	}
+	expectCode(t, errs[1], codes.NotFound)
+	expectCode(t, errs[2], codes.NotFound)
+
+	if got := get(t, s, "b1"); got.Name != "renamed" || got.Version != 2 {
+		t.Fatalf("got %+v, want b1 renamed at version 2", got)
+	}
+	if got := get(t, s, "b2"); got.Name != "board b2" || got.Version != 1 {
+		t.Fatalf("got %+v, want b2 untouched", got)
+	}
+	if got := sorted(ids(find(t, s, board.Filter{}))); !equal(got, []string{"b1", "b2", "b4"}) {
+		t.Fatalf("got %v, want [b1 b2 b4]", got)
+	}
+}
+
+func testFindByBoardIDs(t *testing.T, s board.Storage) {
+	save(t, s,
+		newBoard("b1", "w1", "o1", 0),
+		newBoard("b2", "w1", "o1", 1),
+		newBoard("b3", "w1", "o1", 2),
+	)
+
+	got := sorted(ids(find(t, s, board.Filter{BoardIDs: []string{"b1", "b3", "missing"}})))
+	if !equal(got, []string{"b1", "b3"}) {
+		t.Fatalf("got %v, want [b1 b3]", got)
+	}
+
+	total, err := s.Count(context.Background(), board.Filter{BoardIDs: []string{"b1", "b3"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 2 {
+		t.Fatalf("count %d, want 2", total)
+	}
+}
+
+func testFindByWorkspaceOwnerMember(t *testing.T, s board.Storage) {
+	save(t, s,
+		newBoard("b1", "w1", "o1", 0, "m1"),
+		newBoard("b2", "w1", "o2", 1, "m2"),
+		newBoard("b3", "w2", "o1", 2, "m2"),
+		newBoard("b4", "w2", "o3", 3),
+	)
+
+	tests := []struct {
+		filter board.Filter
+		want   []string
+	}{
+		{board.Filter{WorkspaceIDs: []string{"w1"}}, []string{"b1", "b2"}},
+		{board.Filter{WorkspaceIDs: []string{"w1", "w2"}}, []string{"b1", "b2", "b3", "b4"}},
+		{board.Filter{OwnerIDs: []string{"o1"}}, []string{"b1", "b3"}},
+		{board.Filter{MemberIDs: []string{"m2"}}, []string{"b2", "b3"}},
+		{board.Filter{OwnerIDs: []string{"o3"}, MemberIDs: []string{"m1"}}, []string{"b1", "b4"}},
+		{board.Filter{WorkspaceIDs: []string{"w2"}, OwnerIDs: []string{"o1"}}, []string{"b3"}},
+		{board.Filter{WorkspaceIDs: []string{"w3"}}, nil},
+	}
+	for _, tt := range tests {
+		got := sorted(ids(find(t, s, tt.filter)))
+		if !equal(got, tt.want) {
+			t.Errorf("find %+v: got %v, want %v", tt.filter, got, tt.want)
+		}
+
+		total, err := s.Count(context.Background(), tt.filter)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if total != uint64(len(tt.want)) {
+			t.Errorf("count %+v: got %d, want %d", tt.filter, total, len(tt.want))
+		}
+	}
+}
+
+func testFindPages(t *testing.T, s board.Storage) {
+	save(t, s,
+		newBoard("b1", "w1", "o1", 0),
+		newBoard("b2", "w1", "o1", 1),
+		newBoard("b3", "w1", "o1", 2),
+	)
+
+	// newest first
+	var got []string
+	for index := uint64(0); index < 3; index++ {
+		data, err := s.Find(context.Background(), board.Filter{WorkspaceIDs: []string{"w1"}}, index, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, ids(data)...)
+	}
+	if !equal(got, []string{"b3", "b2", "b1"}) {
+		t.Fatalf("got %v, want [b3 b2 b1]", got)
+	}
+}
+
+func testFindPagesAcrossIndexes(t *testing.T, s board.Storage) {
+	save(t, s,
+		newBoard("b1", "w1", "o1", 0, "m1"),
+		newBoard("b2", "w1", "o2", 1, "m1"),
+		newBoard("b3", "w2", "o1", 2),
+		newBoard("b4", "w2", "o3", 3),
+		newBoard("b5", "w1", "o1", 4, "m1"),
+	)
+
+	// b1 and b5 match both the owner and the member, and come up once
+	filter := board.Filter{OwnerIDs: []string{"o1"}, MemberIDs: []string{"m1"}}
+	var got []string
+	for index := uint64(0); index < 5; index += 2 {
+		data, err := s.Find(context.Background(), filter, index, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, ids(data)...)
+	}
+	if !equal(got, []string{"b5", "b3", "b2", "b1"}) {
+		t.Fatalf("got %v, want [b5 b3 b2 b1]", got)
+	}
+
+	filter.WorkspaceIDs = []string{"w1"}
+	data, err := s.Find(context.Background(), filter, 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !equal(ids(data), []string{"b2"}) {
+		t.Fatalf("got %v, want [b2]", ids(data))
+	}
+	if total, err := s.Count(context.Background(), filter); err != nil || total != 3 {
+		t.Fatalf("count: got %d (%v), want 3", total, err)
+	}
+}
+
+func testFindFields(t *testing.T, s board.Storage) {
+	save(t, s, newBoard("b1", "w1", "o1", 0, "m1"))
+
+	data, err := s.Find(context.Background(), board.Filter{BoardIDs: []string{"b1"}, Fields: []string{"board_id", "name"}}, 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data) != 1 || data[0].BoardID != "b1" || data[0].Name != "board b1" || len(data[0].Members) > 0 {
+		t.Fatalf("got %+v, want the id and the name of b1 without its members", data)
+	}
+}
+
+func testDelete(t *testing.T, s board.Storage) {
+	save(t, s, newBoard("b1", "w1", "o1", 0, "m1"), newBoard("b2", "w1", "o1", 1))
+
+	if err := s.Delete(context.Background(), "b1"); err != nil {
+		t.Fatal(err)
+	}
+	expectCode(t, s.Delete(context.Background(), "b1"), codes.NotFound)
+
+	if got := ids(find(t, s, board.Filter{WorkspaceIDs: []string{"w1"}})); !equal(got, []string{"b2"}) {
+		t.Fatalf("got %v, want [b2]", got)
+	}
+	if got := find(t, s, board.Filter{MemberIDs: []string{"m1"}}); len(got) != 0 {
+		t.Fatalf("deleted board still found by member: %v", ids(got))
+	}
+}
+
+func testDeleteMany(t *testing.T, s board.Storage) {
+	save(t, s, newBoard("b1", "w1", "o1", 0), newBoard("b2", "w1", "o1", 1))
+
+	errs, err := s.DeleteMany(context.Background(), []string{"b1", "missing", "b2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(errs) != 3 || errs[0] != nil || errs[2] != nil {
+		t.Fatalf("got %v, want only the missing board to fail", errs)
+	}
+	expectCode(t, errs[1], codes.NotFound)
+
+	if got := find(t, s, board.Filter{WorkspaceIDs: []string{"w1"}}); len(got) != 0 {
+		t.Fatalf("got %v, want none", ids(got))
+	}
+}
+
+func testTransfer(t *testing.T, s board.Storage) {
+	save(t, s, newBoard("b1", "w1", "o1", 0))
+
+	if err := s.Transfer(context.Background(), "b1", "o2"); err != nil {
+		t.Fatal(err)
+	}
+	got := get(t, s, "b1")
+	if got.OwnerID != "o2" || got.Version != 2 {
+		t.Fatalf("got owner %s at version %d, want o2 at version 2", got.OwnerID, got.Version)
+	}
+	if found := ids(find(t, s, board.Filter{OwnerIDs: []string{"o1"}})); len(found) != 0 {
+		t.Fatalf("still found by the old owner: %v", found)
+	}
+
+	// to the owner it already has, nothing changes
+	if err := s.Transfer(context.Background(), "b1", "o2"); err != nil {
+		t.Fatal(err)
+	}
+	if got = get(t, s, "b1"); got.Version != 2 {
+		t.Fatalf("version %d, want 2", got.Version)
+	}
+
+	expectCode(t, s.Transfer(context.Background(), "missing", "o2"), codes.NotFound)
+}
+
+func testMove(t *testing.T, s board.Storage) {
+	save(t, s, newBoard("b1", "w1", "o1", 0))
+
+	if err := s.Move(context.Background(), "b1", "w2"); err != nil {
+		t.Fatal(err)
+	}
+	got := get(t, s, "b1")
+	if got.WorkspaceID != "w2" || got.Version != 2 {
+		t.Fatalf("got workspace %s at version %d, want w2 at version 2", got.WorkspaceID, got.Version)
+	}
+	if found := find(t, s, board.Filter{WorkspaceIDs: []string{"w1"}}); len(found) != 0 {
+		t.Fatalf("still found in the old workspace: %v", ids(found))
+	}
+
+	if err := s.Move(context.Background(), "b1", "w2"); err != nil {
+		t.Fatal(err)
+	}
+	if got = get(t, s, "b1"); got.Version != 2 {
+		t.Fatalf("version %d, want 2", got.Version)
+	}
+
+	expectCode(t, s.Move(context.Background(), "missing", "w2"), codes.NotFound)
+}
+
+func testRecordsChanges(t *testing.T, s board.Storage) {
+	ctx, changes := board.WithChanges(context.Background())
+	save(t, s, newBoard("b2", "w1", "o1", 1))
+
+	if err := s.Save(ctx, newBoard("b1", "w1", "o1", 0, "m1")); err != nil {
+		t.Fatal(err)
+	}
+	errs, err := s.SaveMany(ctx, []board.Board{
+		{BoardID: "b1", Name: "renamed", Members: []board.Member{{MemberID: "m1", Delete: true}}},
+		{BoardID: "b1", Members: []board.Member{{MemberID: "m2", Roles: []string{"viewer"}}}},
+	})
+	if err != nil || errs[0] != nil || errs[1] != nil {
+		t.Fatalf("got %v (%v), want no error", errs, err)
+	}
+	if err = s.Transfer(ctx, "b1", "o2"); err != nil {
+		t.Fatal(err)
+	}
+	if err = s.Move(ctx, "b1", "w2"); err != nil {
+		t.Fatal(err)
+	}
+	// nothing to change, nothing recorded
+	if err = s.Move(ctx, "b1", "w2"); err != nil {
+		t.Fatal(err)
+	}
+	if err = s.Delete(ctx, "b1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = s.DeleteMany(ctx, []string{"b2", "missing"}); err != nil {
+		t.Fatal(err)
+	}
+
+	got := changes.List()
+	if len(got) != 7 {
+		t.Fatalf("got %d changes, want 7", len(got))
+	}
+	state := func(b *board.Board) string {
+		if b == nil {
+			return "none"
+		}
+		return fmt.Sprintf("%s/%s/%s %q %v v%d", b.BoardID, b.WorkspaceID, b.OwnerID, b.Name, members(*b), b.Version)
+	}
+	want := []struct{ before, after string }{
+		{"none", `b1/w1/o1 "board b1" [m1] v1`},
+		{`b1/w1/o1 "board b1" [m1] v1`, `b1/w1/o1 "renamed" [] v2`},
+		{`b1/w1/o1 "renamed" [] v2`, `b1/w1/o1 "renamed" [m2] v3`},
+		{`b1/w1/o1 "renamed" [m2] v3`, `b1/w1/o2 "renamed" [m2] v4`},
+		{`b1/w1/o2 "renamed" [m2] v4`, `b1/w2/o2 "renamed" [m2] v5`},
+		{`b1/w2/o2 "renamed" [m2] v5`, "none"},
+		{`b2/w1/o1 "board b2" [] v1`, "none"},
+	}
+	for i, w := range want {
+		if before, after := state(got[i].Before), state(got[i].After); before != w.before || after != w.after {
+			t.Fatalf("change %d: got %s -> %s, want %s -> %s", i, before, after, w.before, w.after)
+		}
+	}
+}
+
+func testRestore(t *testing.T, s board.Storage) {
+	restored := newBoard("b1", "w1", "o1", 0, "m1")
+	restored.Version = 7
+
+	errs, err := s.Restore(context.Background(), []board.Board{restored, newBoard("b2", "w1", "o1", 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, err := range errs {
+		if err != nil {
+			t.Fatalf("item %d: %v", i, err)
+		}
+	}
+	if got := get(t, s, "b1"); got.Version != 7 || got.OwnerID != "o1" {
+		t.Fatalf("got %+v, want it restored as given", got)
+	}
+
+	errs, err = s.Restore(context.Background(), []board.Board{restored, newBoard("b3", "w1", "o1", 2)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(errs) != 2 || errs[1] != nil {
+		t.Fatalf("got %v, want only the existing board to fail", errs)
+	}
+	expectCode(t, errs[0], codes.AlreadyExists)
+	get(t, s, "b3")
+}
+
+// testTenantIsolation runs the storage behind board.NewTenantStorage for two
+// tenants, each of which must neither see nor change the boards of the other.
+func testTenantIsolation(t *testing.T, s board.Storage) {
+	ts := board.NewTenantStorage(s)
+	one := board.WithTenant(context.Background(), board.Tenant{WorkspaceIDs: []string{"w1"}})
+	two := board.WithTenant(context.Background(), board.Tenant{WorkspaceIDs: []string{"w2"}})
+
+	if err := ts.Save(one, newBoard("b1", "", "o1", 0, "m1")); err != nil {
+		t.Fatal(err)
+	}
+	if err := ts.Save(two, newBoard("b2", "", "o1", 1, "m1")); err != nil {
+		t.Fatal(err)
+	}
+	if got := get(t, s, "b1"); got.WorkspaceID != "w1" {
+		t.Fatalf("b1 placed in %q, want w1", got.WorkspaceID)
+	}
+
+	for _, filter := range []board.Filter{
+		{},
+		{BoardIDs: []string{"b1", "b2"}},
+		{OwnerIDs: []string{"o1"}},
+		{MemberIDs: []string{"m1"}},
+		{WorkspaceIDs: []string{"w1", "w2"}},
+	} {
+		data, err := ts.Find(two, filter, 0, 100)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := ids(data); !equal(got, []string{"b2"}) {
+			t.Fatalf("find %+v: got %v, want [b2]", filter, got)
+		}
+		n, err := ts.Count(two, filter)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != 1 {
+			t.Fatalf("count %+v: got %d, want 1", filter, n)
+		}
+	}
+	if data, err := ts.Find(two, board.Filter{WorkspaceIDs: []string{"w1"}}, 0, 100); err != nil || len(data) != 0 {
+		t.Fatalf("find in the other workspace: got %v (%v), want none", ids(data), err)
+	}
+	if n, err := ts.Count(two, board.Filter{WorkspaceIDs: []string{"w1"}}); err != nil || n != 0 {
+		t.Fatalf("count in the other workspace: got %d (%v), want 0", n, err)
+	}
+
+	// the boards of the other tenant do not exist for writes either
+	changed := newBoard("b1", "", "o2", 0)
+	expectCode(t, ts.Save(two, changed), codes.NotFound)
+	changed.WorkspaceID = "w2"
+	expectCode(t, ts.Save(two, changed), codes.NotFound)
+	expectCode(t, ts.Delete(two, "b1"), codes.NotFound)
+	expectCode(t, ts.Transfer(two, "b1", "o2"), codes.NotFound)
+	expectCode(t, ts.Move(two, "b1", "w2"), codes.NotFound)
+	errs, err := ts.DeleteMany(two, []string{"b1", "b2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectCode(t, errs[0], codes.NotFound)
+	if errs[1] != nil {
+		t.Fatalf("b2: %v", errs[1])
+	}
+
+	// nor can a board be created in or moved to a workspace of the other
+	expectCode(t, ts.Save(two, newBoard("b3", "w1", "o1", 2)), codes.PermissionDenied)
+	expectCode(t, ts.Move(one, "b1", "w2"), codes.PermissionDenied)
+
+	got := get(t, s, "b1")
+	if got.WorkspaceID != "w1" || got.OwnerID != "o1" || got.Version != 1 {
+		t.Fatalf("got %+v, want b1 untouched", got)
+	}
+	if data := find(t, s, board.Filter{BoardIDs: []string{"b2", "b3"}}); len(data) != 0 {
+		t.Fatalf("got %v, want b2 deleted and b3 not created", ids(data))
+	}
+}
+
+// testTenantWrites writes with a tenant to the storage itself, as
+// NewTenantStorage does once it checked the board: the storage must still
+// not write a board that left the tenant meanwhile.
+func testTenantWrites(t *testing.T, s board.Storage) {
+	one := board.WithTenant(context.Background(), board.Tenant{WorkspaceIDs: []string{"w1"}})
+	two := board.WithTenant(context.Background(), board.Tenant{WorkspaceIDs: []string{"w2"}})
+	save(t, s, newBoard("b1", "w2", "o1", 0, "m1"))
+
+	expectCode(t, s.Save(one, board.Board{BoardID: "b1", Name: "renamed"}), codes.NotFound)
+	replace := newBoard("b1", "w1", "o1", 0)
+	replace.Replace = true
+	expectCode(t, s.Save(one, replace), codes.NotFound)
+	errs, err := s.SaveMany(one, []board.Board{{BoardID: "b1", Name: "renamed"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectCode(t, errs[0], codes.NotFound)
+	expectCode(t, s.Transfer(one, "b1", "o2"), codes.NotFound)
+	expectCode(t, s.Move(one, "b1", "w1"), codes.NotFound)
+	expectCode(t, s.Move(two, "b1", "w1"), codes.PermissionDenied)
+	expectCode(t, s.Delete(one, "b1"), codes.NotFound)
+	if errs, err = s.DeleteMany(one, []string{"b1"}); err != nil {
+		t.Fatal(err)
+	}
+	expectCode(t, errs[0], codes.NotFound)
+
+	if got := get(t, s, "b1"); got.WorkspaceID != "w2" || got.Name != "board b1" || got.Version != 1 {
+		t.Fatalf("got %+v, want b1 untouched", got)
+	}
+
+	// both tenants write the board while it moves between their workspaces:
+	// every write applied with a tenant found the board in its workspace
+	ctxs := map[string]context.Context{"w1": one, "w2": two}
+	changes := make(map[string]*board.Changes)
+	for ws, ctx := range ctxs {
+		ctxs[ws], changes[ws] = board.WithChanges(ctx)
+	}
+
+	var wg sync.WaitGroup
+	errc := make(chan error, 1000)
+	for ws, ctx := range ctxs {
+		ws, ctx := ws, ctx
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 20; i++ {
+				errc <- s.Save(ctx, board.Board{BoardID: "b1", Name: fmt.Sprintf("%s %d", ws, i)})
+				errc <- s.Transfer(ctx, "b1", fmt.Sprintf("%s-o%d", ws, i%2))
+			}
+		}()
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 20; i++ {
+			errc <- s.Move(context.Background(), "b1", []string{"w1", "w2"}[i%2])
+		}
+	}()
+	wg.Wait()
+	close(errc)
+
+	for err := range errc {
+		if code := status.Code(err); err != nil && code != codes.NotFound && code != codes.Aborted {
+			t.Fatalf("got %v, want the write applied, not found or aborted", err)
+		}
+	}
+	for ws, c := range changes {
+		for _, change := range c.List() {
+			if change.Before.WorkspaceID != ws || change.After.WorkspaceID != ws {
+				t.Fatalf("tenant %s changed %+v into %+v", ws, *change.Before, *change.After)
+			}
+		}
+	}
+}
+
+func testWorkspaceRequired(t *testing.T, s board.Storage) {
+	expectCode(t, s.Save(context.Background(), newBoard("b1", "missing", "o1", 0)), codes.NotFound)
+	errs, err := s.SaveMany(context.Background(), []board.Board{
+		newBoard("b1", "missing", "o1", 0),
+		newBoard("b2", "w1", "o1", 1),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectCode(t, errs[0], codes.NotFound)
+	if errs[1] != nil {
+		t.Fatalf("b2: %v", errs[1])
+	}
+	expectCode(t, s.Move(context.Background(), "b2", "missing"), codes.NotFound)
+
+	if got := ids(find(t, s, board.Filter{})); !equal(got, []string{"b2"}) {
+		t.Fatalf("got %v, want only b2 written", got)
+	}
+	if got := get(t, s, "b2"); got.WorkspaceID != "w1" || got.Version != 1 {
+		t.Fatalf("got %+v, want b2 left in w1", got)
+	}
+
+	// boards outside of any workspace need none
+	save(t, s, newBoard("b3", "", "o1", 2))
+}
@@ -0,0 +1,175 @@
+package storagetest
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-funcards/board-service/internal/board"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sync"
+	"testing"
+)
+
+// RunWorkspaces runs the contract of board.WorkspaceStorage against the
+// storages returned by open, the boards sharing the database of the
+// workspaces. Both must be empty and not shared with the other tests.
+func RunWorkspaces(t *testing.T, open func(t *testing.T) Storages) {
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, s board.Storage, ws board.WorkspaceStorage)
+	}{
+		{"CreateInserts", testWorkspaceCreateInserts},
+		{"UpdateMerges", testWorkspaceUpdateMerges},
+		{"DeleteWithBoards", testWorkspaceDeleteWithBoards},
+		{"DeleteRacesBoards", testWorkspaceDeleteRacesBoards},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			s := open(t)
+			tt.fn(t, s.Boards, s.Workspaces)
+		})
+	}
+}
+
+func newWorkspace(id, ownerID string, members ...string) board.Workspace {
+	w := board.Workspace{
+		WorkspaceID: id,
+		OwnerID:     ownerID,
+		Name:        "workspace " + id,
+		CreatedAt:   created,
+	}
+	for _, m := range members {
+		w.Members = append(w.Members, board.Member{MemberID: m, Roles: []string{"viewer"}})
+	}
+	return w
+}
+
+func getWorkspace(t *testing.T, ws board.WorkspaceStorage, id string) board.Workspace {
+	t.Helper()
+	data, err := ws.Find(context.Background(), board.WorkspaceFilter{WorkspaceIDs: []string{id}}, 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data) != 1 {
+		t.Fatalf("find %s: got %d workspaces, want 1", id, len(data))
+	}
+	return data[0]
+}
+
+func workspaceMembers(w board.Workspace) []string {
+	return members(board.Board{Members: w.Members})
+}
+
+func testWorkspaceCreateInserts(t *testing.T, _ board.Storage, ws board.WorkspaceStorage) {
+	if err := ws.Create(context.Background(), newWorkspace("w1", "o1", "m1")); err != nil {
+		t.Fatal(err)
+	}
+
+	// creating it again neither merges nor takes it over
+	again := newWorkspace("w1", "o2", "m2")
+	again.Name = "taken"
+	expectCode(t, ws.Create(context.Background(), again), codes.AlreadyExists)
+
+	got := getWorkspace(t, ws, "w1")
+	if got.OwnerID != "o1" || got.Name != "workspace w1" || !equal(workspaceMembers(got), []string{"m1"}) {
+		t.Fatalf("got %+v, want it as first created", got)
+	}
+}
+
+func testWorkspaceUpdateMerges(t *testing.T, _ board.Storage, ws board.WorkspaceStorage) {
+	expectCode(t, ws.Update(context.Background(), board.Workspace{WorkspaceID: "missing", Name: "x"}), codes.NotFound)
+	if n, err := ws.Count(context.Background(), board.WorkspaceFilter{}); err != nil || n != 0 {
+		t.Fatalf("got %d workspaces (%v), want the update not to create one", n, err)
+	}
+
+	if err := ws.Create(context.Background(), newWorkspace("w1", "o1", "m1", "m2")); err != nil {
+		t.Fatal(err)
+	}
+	err := ws.Update(context.Background(), board.Workspace{
+		WorkspaceID: "w1",
+		Members: []board.Member{
+			{MemberID: "m1", Delete: true},
+			{MemberID: "m3", Roles: []string{"editor"}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := getWorkspace(t, ws, "w1")
+	if got.OwnerID != "o1" || got.Name != "workspace w1" || !equal(workspaceMembers(got), []string{"m2", "m3"}) {
+		t.Fatalf("got %+v, want the name kept and members m2 and m3", got)
+	}
+}
+
+func testWorkspaceDeleteWithBoards(t *testing.T, s board.Storage, ws board.WorkspaceStorage) {
+	if err := ws.Create(context.Background(), newWorkspace("w1", "o1")); err != nil {
+		t.Fatal(err)
+	}
+	save(t, s, newBoard("b1", "w1", "o1", 0))
+
+	expectCode(t, ws.Delete(context.Background(), "w1"), codes.Aborted)
+	getWorkspace(t, ws, "w1")
+
+	if err := s.Delete(context.Background(), "b1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := ws.Delete(context.Background(), "w1"); err != nil {
+		t.Fatal(err)
+	}
+	expectCode(t, ws.Delete(context.Background(), "w1"), codes.NotFound)
+}
+
+// testWorkspaceDeleteRacesBoards deletes workspaces while boards are written
+// to them: either the delete fails, or the board is not written.
+func testWorkspaceDeleteRacesBoards(t *testing.T, s board.Storage, ws board.WorkspaceStorage) {
+	for i := 0; i < 20; i++ {
+		id := fmt.Sprintf("w%d", i)
+		if err := ws.Create(context.Background(), newWorkspace(id, "o1")); err != nil {
+			t.Fatal(err)
+		}
+		save(t, s, newBoard(fmt.Sprintf("moved%d", i), "", "o1", i))
+
+		var wg sync.WaitGroup
+		var created, moved, deleted error
+		wg.Add(3)
+		go func() {
+			defer wg.Done()
+			created = s.Save(context.Background(), newBoard(fmt.Sprintf("b%d", i), id, "o1", i))
+		}()
+		go func() {
+			defer wg.Done()
+			moved = s.Move(context.Background(), fmt.Sprintf("moved%d", i), id)
+		}()
+		go func() {
+			defer wg.Done()
+			deleted = ws.Delete(context.Background(), id)
+		}()
+		wg.Wait()
+
+		for _, err := range []error{created, moved} {
+			if code := status.Code(err); err != nil && code != codes.NotFound && code != codes.Aborted {
+				t.Fatalf("write into %s: %v", id, err)
+			}
+		}
+		if code := status.Code(deleted); deleted != nil && code != codes.Aborted {
+			t.Fatalf("delete %s: %v", id, deleted)
+		}
+
+		n, err := s.Count(context.Background(), board.Filter{WorkspaceIDs: []string{id}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		exists, err := ws.Count(context.Background(), board.WorkspaceFilter{WorkspaceIDs: []string{id}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if exists == 0 && n > 0 {
+			t.Fatalf("%s deleted with %d boards (created: %v, moved: %v)", id, n, created, moved)
+		}
+		if (deleted == nil) != (exists == 0) {
+			t.Fatalf("%s: delete returned %v, but %d left", id, deleted, exists)
+		}
+	}
+}
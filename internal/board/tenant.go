@@ -0,0 +1,226 @@
+package board
+
+import (
+	"context"
+	"github.com/go-funcards/board-service/proto/v1"
+	"github.com/go-funcards/slice"
+	"github.com/golang-jwt/jwt/v4"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"strings"
+)
+
+const (
+	MetadataWorkspaceID   = "workspace-id"
+	MetadataAuthorization = "authorization"
+)
+
+// Tenant is the set of workspaces a caller may act in. Boards of other
+// workspaces do not exist for the caller.
+type Tenant struct {
+	WorkspaceIDs []string
+}
+
+func (t Tenant) Allows(workspaceID string) bool {
+	return slice.Contains(t.WorkspaceIDs, workspaceID)
+}
+
+type tenantKey struct{}
+
+func WithTenant(ctx context.Context, t Tenant) context.Context {
+	return context.WithValue(ctx, tenantKey{}, t)
+}
+
+// TenantFromContext returns the tenant of the call. Without one, as for the
+// backup command or when tenancy is optional, nothing is scoped.
+func TenantFromContext(ctx context.Context) (Tenant, bool) {
+	t, ok := ctx.Value(tenantKey{}).(Tenant)
+	return t, ok
+}
+
+// scope narrows ids, the workspaces asked for, to the tenant of ctx: all of
+// its workspaces when none were asked for. It reports false when none is left,
+// since an empty list would not scope anything.
+func scope(ctx context.Context, ids []string) ([]string, bool) {
+	t, ok := TenantFromContext(ctx)
+	if !ok {
+		return ids, true
+	}
+	if len(ids) == 0 {
+		return t.WorkspaceIDs, true
+	}
+	ids = slice.Filter(ids, t.Allows)
+	return ids, len(ids) > 0
+}
+
+// Allowed reports whether the tenant of ctx, if any, may act in workspaceID.
+// Storages check the workspace of the boards they write with it as part of
+// the write, which NewTenantStorage only checks with a read before it.
+func Allowed(ctx context.Context, workspaceID string) bool {
+	t, ok := TenantFromContext(ctx)
+	return !ok || t.Allows(workspaceID)
+}
+
+// TenantResolver finds the tenant of a call in a claim of the bearer JWT, a
+// string or a list of strings, or else in the workspace-id metadata, which may
+// hold several ids separated by commas. With the claim the metadata can only
+// narrow the tenant to some of its workspaces. The JWT is not verified here:
+// authentication is expected to happen in front of the service, like for the
+// rate limits.
+type TenantResolver struct {
+	// Claim names the JWT claim holding the workspaces.
+	Claim string
+	// Required rejects calls without a tenant. Otherwise they are not scoped.
+	Required bool
+}
+
+func (r TenantResolver) resolve(ctx context.Context) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	var ids []string
+	for _, value := range md.Get(MetadataWorkspaceID) {
+		ids = append(ids, splitIDs(value)...)
+	}
+
+	if len(r.Claim) > 0 {
+		if values := md.Get(MetadataAuthorization); len(values) > 0 {
+			if claimed, ok := workspaceClaim(values[0], r.Claim); ok {
+				if len(claimed) == 0 {
+					return nil, PermissionDenied(ResourceWorkspace, "", "the "+r.Claim+" claim of the caller names no workspace")
+				}
+				for _, id := range ids {
+					if !slice.Contains(claimed, id) {
+						return nil, PermissionDenied(ResourceWorkspace, id, "workspace is not in the "+r.Claim+" claim of the caller")
+					}
+				}
+				if len(ids) == 0 {
+					ids = claimed
+				}
+			}
+		}
+	}
+
+	if len(ids) == 0 {
+		if r.Required {
+			return nil, Unauthenticated("workspace is required, send a JWT with the " + r.Claim + " claim or the workspace-id metadata")
+		}
+		return ctx, nil
+	}
+	return WithTenant(ctx, Tenant{WorkspaceIDs: ids}), nil
+}
+
+// narrow scopes ctx to the workspace_id of req, when it has one, which must
+// belong to the tenant.
+func narrow(ctx context.Context, req any) (context.Context, error) {
+	in, ok := req.(interface{ GetWorkspaceId() string })
+	if !ok || len(in.GetWorkspaceId()) == 0 {
+		return ctx, nil
+	}
+	if !Allowed(ctx, in.GetWorkspaceId()) {
+		return nil, PermissionDenied(ResourceWorkspace, in.GetWorkspaceId(), "workspace is not available to the caller")
+	}
+	return WithTenant(ctx, Tenant{WorkspaceIDs: []string{in.GetWorkspaceId()}}), nil
+}
+
+// TenantUnaryServerInterceptor puts the tenant of calls to the board service
+// in their context, narrowed to the workspace_id of the request.
+func TenantUnaryServerInterceptor(r TenantResolver) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !boardMethod(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		ctx, err := r.resolve(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if ctx, err = narrow(ctx, req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// TenantStreamServerInterceptor is TenantUnaryServerInterceptor for streams,
+// narrowing to the workspace_id of every message received.
+func TenantStreamServerInterceptor(r TenantResolver) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !boardMethod(info.FullMethod) {
+			return handler(srv, stream)
+		}
+
+		ctx, err := r.resolve(stream.Context())
+		if err != nil {
+			return err
+		}
+		return handler(srv, &tenantStream{ServerStream: stream, ctx: ctx})
+	}
+}
+
+type tenantStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *tenantStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *tenantStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	ctx, err := narrow(s.ctx, m)
+	if err != nil {
+		return err
+	}
+	s.ctx = ctx
+	return nil
+}
+
+func boardMethod(method string) bool {
+	return strings.HasPrefix(method, "/"+v1.Board_ServiceDesc.ServiceName+"/")
+}
+
+// bearerClaims returns the claims of the bearer JWT in authorization, if any.
+func bearerClaims(authorization string) jwt.MapClaims {
+	token := strings.TrimSpace(authorization)
+	if len(token) < 7 || !strings.EqualFold(token[:7], "bearer ") {
+		return nil
+	}
+
+	claims := jwt.MapClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(strings.TrimSpace(token[7:]), claims); err != nil {
+		return nil
+	}
+	return claims
+}
+
+// workspaceClaim returns the workspaces in claim of the bearer JWT in
+// authorization, reporting whether the JWT has the claim at all. A claim that
+// is neither a string nor a list names no workspace.
+func workspaceClaim(authorization, claim string) ([]string, bool) {
+	value, ok := bearerClaims(authorization)[claim]
+	if !ok {
+		return nil, false
+	}
+
+	var ids []string
+	switch value := value.(type) {
+	case string:
+		ids = splitIDs(value)
+	case []any:
+		for _, item := range value {
+			if id, ok := item.(string); ok && len(id) > 0 {
+				ids = append(ids, id)
+			}
+		}
+	}
+	return ids, true
+}
+
+func splitIDs(value string) []string {
+	return slice.Filter(slice.Map(strings.Split(value, ","), strings.TrimSpace), func(id string) bool {
+		return len(id) > 0
+	})
+}
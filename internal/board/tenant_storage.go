@@ -0,0 +1,224 @@
+package board
+
+import (
+	"context"
+	"github.com/go-funcards/slice"
+)
+
+var _ Storage = (*tenantStorage)(nil)
+
+// tenantStorage keeps every call within the workspaces of the tenant of its
+// context, see TenantFromContext. Filters are scoped to those workspaces, new
+// boards are placed in one of them, and writes to boards of other workspaces
+// fail with NotFound, as reads do not find them either. Calls without a
+// tenant pass through unscoped.
+//
+// A board keeps its workspace across saves, only Move changes it, so the
+// workspace is checked with a read before the write.
+type tenantStorage struct {
+	Storage
+}
+
+func NewTenantStorage(storage Storage) *tenantStorage {
+	return &tenantStorage{Storage: storage}
+}
+
+func (s *tenantStorage) Save(ctx context.Context, model Board) error {
+	models, errs, err := s.place(ctx, []Board{model})
+	if err != nil {
+		return err
+	}
+	if errs[0] != nil {
+		return errs[0]
+	}
+	return s.Storage.Save(ctx, models[0])
+}
+
+func (s *tenantStorage) SaveMany(ctx context.Context, models []Board) ([]error, error) {
+	models, errs, err := s.place(ctx, models)
+	if err != nil {
+		return errs, err
+	}
+	return s.each(errs, func(items []int) ([]error, error) {
+		return s.Storage.SaveMany(ctx, slice.Map(items, func(i int) Board {
+			return models[i]
+		}))
+	})
+}
+
+func (s *tenantStorage) Delete(ctx context.Context, id string) error {
+	errs, err := s.check(ctx, []string{id})
+	if err != nil {
+		return err
+	}
+	if errs[0] != nil {
+		return errs[0]
+	}
+	return s.Storage.Delete(ctx, id)
+}
+
+func (s *tenantStorage) DeleteMany(ctx context.Context, ids []string) ([]error, error) {
+	errs, err := s.check(ctx, ids)
+	if err != nil {
+		return errs, err
+	}
+	return s.each(errs, func(items []int) ([]error, error) {
+		return s.Storage.DeleteMany(ctx, slice.Map(items, func(i int) string {
+			return ids[i]
+		}))
+	})
+}
+
+func (s *tenantStorage) Transfer(ctx context.Context, id, ownerID string) error {
+	errs, err := s.check(ctx, []string{id})
+	if err != nil {
+		return err
+	}
+	if errs[0] != nil {
+		return errs[0]
+	}
+	return s.Storage.Transfer(ctx, id, ownerID)
+}
+
+// Move also requires workspaceID to be a workspace of the tenant.
+func (s *tenantStorage) Move(ctx context.Context, id, workspaceID string) error {
+	errs, err := s.check(ctx, []string{id})
+	if err != nil {
+		return err
+	}
+	if errs[0] != nil {
+		return errs[0]
+	}
+	if !Allowed(ctx, workspaceID) {
+		return PermissionDenied(ResourceWorkspace, workspaceID, "workspace is not available to the caller")
+	}
+	return s.Storage.Move(ctx, id, workspaceID)
+}
+
+func (s *tenantStorage) Restore(ctx context.Context, models []Board) ([]error, error) {
+	models, errs, err := s.place(ctx, models)
+	if err != nil {
+		return errs, err
+	}
+	return s.each(errs, func(items []int) ([]error, error) {
+		return s.Storage.Restore(ctx, slice.Map(items, func(i int) Board {
+			return models[i]
+		}))
+	})
+}
+
+func (s *tenantStorage) Find(ctx context.Context, filter Filter, index uint64, size uint32) ([]Board, error) {
+	ids, ok := scope(ctx, filter.WorkspaceIDs)
+	if !ok {
+		return nil, nil
+	}
+	filter.WorkspaceIDs = ids
+	return s.Storage.Find(ctx, filter, index, size)
+}
+
+func (s *tenantStorage) Count(ctx context.Context, filter Filter) (uint64, error) {
+	ids, ok := scope(ctx, filter.WorkspaceIDs)
+	if !ok {
+		return 0, nil
+	}
+	filter.WorkspaceIDs = ids
+	return s.Storage.Count(ctx, filter)
+}
+
+// place checks that the existing boards among models belong to the tenant
+// and gives the new ones a workspace: the one asked for, which the tenant
+// must have, or the only one the tenant has.
+func (s *tenantStorage) place(ctx context.Context, models []Board) ([]Board, []error, error) {
+	errs := make([]error, len(models))
+	t, ok := TenantFromContext(ctx)
+	if !ok {
+		return models, errs, nil
+	}
+
+	current, err := s.current(ctx, slice.Map(models, func(item Board) string {
+		return item.BoardID
+	}))
+	if err != nil {
+		return nil, errs, err
+	}
+
+	placed := slice.Copy(models)
+	for i, model := range placed {
+		if b, exists := current[model.BoardID]; exists {
+			if !t.Allows(b.WorkspaceID) {
+				errs[i] = NotFound(ResourceBoard, model.BoardID, nil)
+			}
+			placed[i].WorkspaceID = b.WorkspaceID
+			continue
+		}
+
+		switch {
+		case len(model.WorkspaceID) == 0 && len(t.WorkspaceIDs) == 1:
+			placed[i].WorkspaceID = t.WorkspaceIDs[0]
+		case len(model.WorkspaceID) == 0:
+			errs[i] = InvalidArgument(FieldViolation{
+				Field:       "workspace_id",
+				Description: "required when the caller has several workspaces",
+			})
+		case !t.Allows(model.WorkspaceID):
+			errs[i] = PermissionDenied(ResourceWorkspace, model.WorkspaceID, "workspace is not available to the caller")
+		}
+	}
+	return placed, errs, nil
+}
+
+// check fails the ids of boards outside the workspaces of the tenant.
+func (s *tenantStorage) check(ctx context.Context, ids []string) ([]error, error) {
+	errs := make([]error, len(ids))
+	t, ok := TenantFromContext(ctx)
+	if !ok {
+		return errs, nil
+	}
+
+	current, err := s.current(ctx, ids)
+	if err != nil {
+		return errs, err
+	}
+	for i, id := range ids {
+		if b, exists := current[id]; exists && !t.Allows(b.WorkspaceID) {
+			errs[i] = NotFound(ResourceBoard, id, nil)
+		}
+	}
+	return errs, nil
+}
+
+// current loads the boards among ids from every workspace.
+func (s *tenantStorage) current(ctx context.Context, ids []string) (map[string]Board, error) {
+	data, err := s.Storage.Find(ctx, Filter{BoardIDs: ids}, 0, uint32(len(ids)))
+	if err != nil {
+		return nil, err
+	}
+
+	boards := make(map[string]Board, len(data))
+	for _, item := range data {
+		boards[item.BoardID] = item
+	}
+	return boards, nil
+}
+
+// each runs fn with the indexes of the items that did not fail yet, and
+// merges the errors it returns for them into errs.
+func (s *tenantStorage) each(errs []error, fn func(items []int) ([]error, error)) ([]error, error) {
+	items := make([]int, 0, len(errs))
+	for i, err := range errs {
+		if err == nil {
+			items = append(items, i)
+		}
+	}
+	if len(items) == 0 {
+		return errs, nil
+	}
+
+	results, err := fn(items)
+	for j, i := range items {
+		if j < len(results) {
+			errs[i] = results[j]
+		}
+	}
+	return errs, err
+}
@@ -0,0 +1,96 @@
+package board
+
+import (
+	"context"
+	"github.com/golang-jwt/jwt/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func bearer(t *testing.T, claims jwt.MapClaims) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return "Bearer " + token
+}
+
+func TestTenantResolverClaimIsAuthoritative(t *testing.T) {
+	one := bearer(t, jwt.MapClaims{"sub": "u1", "workspace_id": []any{"w1", "w3"}})
+	none := bearer(t, jwt.MapClaims{"sub": "u1", "workspace_id": []any{}})
+	other := bearer(t, jwt.MapClaims{"sub": "u1"})
+
+	tests := []struct {
+		name string
+		md   []string
+		want []string
+		code codes.Code
+	}{
+		{"claim", []string{MetadataAuthorization, one}, []string{"w1", "w3"}, codes.OK},
+		{"header narrows the claim", []string{MetadataAuthorization, one, MetadataWorkspaceID, "w3"}, []string{"w3"}, codes.OK},
+		{"header spoofs another tenant", []string{MetadataAuthorization, one, MetadataWorkspaceID, "w2"}, nil, codes.PermissionDenied},
+		{"header widens the claim", []string{MetadataAuthorization, one, MetadataWorkspaceID, "w1,w2"}, nil, codes.PermissionDenied},
+		{"claim names no workspace", []string{MetadataAuthorization, none, MetadataWorkspaceID, "w2"}, nil, codes.PermissionDenied},
+		{"header without the claim", []string{MetadataAuthorization, other, MetadataWorkspaceID, "w2"}, []string{"w2"}, codes.OK},
+		{"header without a token", []string{MetadataWorkspaceID, "w1, w2"}, []string{"w1", "w2"}, codes.OK},
+		{"neither", nil, nil, codes.Unauthenticated},
+	}
+
+	r := TenantResolver{Claim: "workspace_id", Required: true}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(tt.md...))
+			ctx, err := r.resolve(ctx)
+			if got := status.Code(err); got != tt.code {
+				t.Fatalf("got %v (%v), want %v", got, err, tt.code)
+			}
+			if err != nil {
+				return
+			}
+			tenant, _ := TenantFromContext(ctx)
+			if !equalIDs(tenant.WorkspaceIDs, tt.want) {
+				t.Fatalf("got tenant %v, want %v", tenant.WorkspaceIDs, tt.want)
+			}
+		})
+	}
+}
+
+func TestNarrowRejectsWorkspacesOutsideTheTenant(t *testing.T) {
+	ctx := WithTenant(context.Background(), Tenant{WorkspaceIDs: []string{"w1"}})
+
+	_, err := narrow(ctx, &requestWithWorkspace{workspaceID: "w2"})
+	if got := status.Code(err); got != codes.PermissionDenied {
+		t.Fatalf("got %v (%v), want %v", got, err, codes.PermissionDenied)
+	}
+
+	ctx, err = narrow(ctx, &requestWithWorkspace{workspaceID: "w1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tenant, _ := TenantFromContext(ctx); !equalIDs(tenant.WorkspaceIDs, []string{"w1"}) {
+		t.Fatalf("got tenant %v, want [w1]", tenant.WorkspaceIDs)
+	}
+}
+
+type requestWithWorkspace struct {
+	workspaceID string
+}
+
+func (r *requestWithWorkspace) GetWorkspaceId() string {
+	return r.workspaceID
+}
+
+func equalIDs(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
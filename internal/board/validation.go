@@ -0,0 +1,163 @@
+package board
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-funcards/board-service/proto/v1"
+	"github.com/go-funcards/slice"
+	"github.com/go-funcards/validate"
+	"github.com/go-playground/validator/v10"
+	"google.golang.org/grpc"
+	"strings"
+	"sync/atomic"
+	"unicode"
+)
+
+// requests are the messages validation rules are registered for.
+var requests = []any{
+	v1.CreateBoardRequest_Member{},
+	v1.CreateBoardRequest{},
+	v1.UpdateBoardRequest_Member{},
+	v1.UpdateBoardRequest{},
+	v1.DeleteBoardRequest{},
+	v1.BoardRequest{},
+	v1.BatchCreateBoardsRequest{},
+	v1.BatchUpdateBoardsRequest{},
+	v1.BatchDeleteBoardsRequest{},
+	v1.BoardsRequest{},
+	v1.BoardAuditRequest{},
+	v1.BoardVersionsRequest{},
+	v1.BoardVersionRequest{},
+	v1.RevertBoardRequest{},
+	v1.TransferBoardRequest{},
+	v1.MoveBoardRequest{},
+	v1.UsageRequest{},
+	v1.ExportBoardsRequest{},
+	v1.ImportChunk{},
+	v1.CreateWorkspaceRequest_Member{},
+	v1.CreateWorkspaceRequest{},
+	v1.UpdateWorkspaceRequest_Member{},
+	v1.UpdateWorkspaceRequest{},
+	v1.DeleteWorkspaceRequest{},
+	v1.WorkspaceRequest{},
+	v1.WorkspacesRequest{},
+}
+
+// NewValidator registers rules for the request messages. Rules using unknown
+// validation tags are rejected instead of panicking on the first request.
+func NewValidator(rules validate.TypeRules) (v *validate.Validator, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("invalid validation rules: %v", r)
+		}
+	}()
+
+	v = new(validate.Validator)
+	v.RegisterStructRules(rules, requests...)
+	for _, req := range requests {
+		_ = v.ValidateStruct(req)
+	}
+	return v, nil
+}
+
+// ValidatorRef points at the validator requests are checked with, so that it
+// can be replaced when the rules change.
+type ValidatorRef struct {
+	v atomic.Value
+}
+
+func NewValidatorRef(v *validate.Validator) *ValidatorRef {
+	r := new(ValidatorRef)
+	r.Store(v)
+	return r
+}
+
+func (r *ValidatorRef) Load() *validate.Validator {
+	return r.v.Load().(*validate.Validator)
+}
+
+func (r *ValidatorRef) Store(v *validate.Validator) {
+	r.v.Store(v)
+}
+
+// ValidatorUnaryServerInterceptor validates requests with the validator of r,
+// reporting every failed rule as a BadRequest field violation named after the
+// proto field.
+func ValidatorUnaryServerInterceptor(r *ValidatorRef) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := validateRequest(r.Load(), req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func ValidatorStreamServerInterceptor(r *ValidatorRef) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &validatedStream{ServerStream: stream, r: r})
+	}
+}
+
+type validatedStream struct {
+	grpc.ServerStream
+	r *ValidatorRef
+}
+
+func (s *validatedStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return validateRequest(s.r.Load(), m)
+}
+
+func validateRequest(v *validate.Validator, req any) error {
+	if req == nil {
+		return InvalidArgument(FieldViolation{Description: "request is required"})
+	}
+
+	err := v.ValidateStruct(req)
+	if err == nil {
+		return nil
+	}
+
+	var ve validator.ValidationErrors
+	if !errors.As(err, &ve) {
+		return InvalidArgument(FieldViolation{Description: err.Error()})
+	}
+
+	return InvalidArgument(slice.Map(ve, func(fe validator.FieldError) FieldViolation {
+		rule := fe.Tag()
+		if len(fe.Param()) > 0 {
+			rule = fmt.Sprintf("%s=%s", rule, fe.Param())
+		}
+		return FieldViolation{
+			Field:       fieldPath(fe.Namespace()),
+			Description: fmt.Sprintf("value does not satisfy %q", rule),
+		}
+	})...)
+}
+
+// fieldPath turns a validator namespace such as "CreateBoardRequest.Members[0].MemberId"
+// into the proto field path "members[0].member_id".
+func fieldPath(namespace string) string {
+	parts := strings.Split(namespace, ".")
+	if len(parts) > 1 {
+		parts = parts[1:]
+	}
+	return strings.Join(slice.Map(parts, snakeCase), ".")
+}
+
+func snakeCase(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
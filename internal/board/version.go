@@ -0,0 +1,61 @@
+package board
+
+import (
+	"github.com/go-funcards/board-service/proto/v1"
+	"github.com/go-funcards/slice"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+type BoardVersion struct {
+	BoardID   string    `json:"board_id" bson:"board_id,omitempty"`
+	Version   uint64    `json:"version" bson:"version,omitempty"`
+	Board     Board     `json:"board" bson:"board"`
+	CreatedAt time.Time `json:"created_at" bson:"created_at,omitempty"`
+}
+
+func (v BoardVersion) toProto() *v1.BoardVersionsResponse_Version {
+	return &v1.BoardVersionsResponse_Version{
+		Version:   v.Version,
+		Board:     v.Board.toProto(nil),
+		CreatedAt: timestamppb.New(v.CreatedAt),
+	}
+}
+
+func CreateBoardVersion(model Board) BoardVersion {
+	return BoardVersion{
+		BoardID:   model.BoardID,
+		Version:   model.Version,
+		Board:     model,
+		CreatedAt: time.Now().UTC(),
+	}
+}
+
+// RevertBoard builds the update restoring the name, metadata and members of
+// version onto current, as a replacement: empty fields of the snapshot are
+// written empty and the members not in it are removed in the same write. The
+// board keeps its owner.
+func RevertBoard(current Board, version BoardVersion) Board {
+	return Board{
+		BoardID:  current.BoardID,
+		OwnerID:  current.OwnerID,
+		Name:     version.Board.Name,
+		Metadata: version.Board.Metadata,
+		Members:  slice.Copy(version.Board.Members),
+		Replace:  true,
+	}
+}
+
+// RestoreBoard builds the board recreating a deleted one as it was in version,
+// with its workspace, owner and creation time.
+func RestoreBoard(version BoardVersion) Board {
+	return Board{
+		BoardID:     version.BoardID,
+		WorkspaceID: version.Board.WorkspaceID,
+		OwnerID:     version.Board.OwnerID,
+		Name:        version.Board.Name,
+		Metadata:    version.Board.Metadata,
+		CreatedAt:   version.Board.CreatedAt,
+		Members:     slice.Copy(version.Board.Members),
+	}
+}
@@ -0,0 +1,118 @@
+package board
+
+import (
+	"context"
+	"github.com/go-funcards/board-service/internal/tracing"
+	"github.com/go-funcards/slice"
+	"github.com/rs/zerolog"
+)
+
+var _ Storage = (*versionStorage)(nil)
+
+// versionStorage appends a BoardVersion snapshot after every write applied through the wrapped Storage.
+type versionStorage struct {
+	Storage
+	versions VersionStorage
+	log      zerolog.Logger
+}
+
+func NewVersionStorage(storage Storage, versions VersionStorage, log zerolog.Logger) *versionStorage {
+	return &versionStorage{
+		Storage:  storage,
+		versions: versions,
+		log:      log.With().Str("storage", "version").Logger(),
+	}
+}
+
+func (s *versionStorage) Save(ctx context.Context, model Board) error {
+	models, err := s.resume(ctx, []Board{model})
+	if err != nil {
+		return err
+	}
+
+	ctx, changes := WithChanges(ctx)
+	err = s.Storage.Save(ctx, models[0])
+	s.snapshot(ctx, changes)
+	return err
+}
+
+func (s *versionStorage) SaveMany(ctx context.Context, models []Board) ([]error, error) {
+	models, err := s.resume(ctx, models)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, changes := WithChanges(ctx)
+	errs, err := s.Storage.SaveMany(ctx, models)
+	s.snapshot(ctx, changes)
+	return errs, err
+}
+
+func (s *versionStorage) Transfer(ctx context.Context, id, ownerID string) error {
+	ctx, changes := WithChanges(ctx)
+	err := s.Storage.Transfer(ctx, id, ownerID)
+	s.snapshot(ctx, changes)
+	return err
+}
+
+func (s *versionStorage) Move(ctx context.Context, id, workspaceID string) error {
+	ctx, changes := WithChanges(ctx)
+	err := s.Storage.Move(ctx, id, workspaceID)
+	s.snapshot(ctx, changes)
+	return err
+}
+
+// resume has the boards that do not exist continue from the latest version
+// recorded for their id, when they were deleted and are created again, so
+// that their snapshots do not collide with the ones of the deleted board.
+func (s *versionStorage) resume(ctx context.Context, models []Board) ([]Board, error) {
+	ids := slice.Map(models, func(item Board) string {
+		return item.BoardID
+	})
+	data, err := s.Storage.Find(ctx, Filter{BoardIDs: ids, Fields: []string{"board_id"}}, 0, uint32(len(ids)))
+	if err != nil {
+		return nil, err
+	}
+	existing := slice.Map(data, func(item Board) string {
+		return item.BoardID
+	})
+
+	models = slice.Copy(models)
+	for i, model := range models {
+		if slice.Contains(existing, model.BoardID) {
+			continue
+		}
+		latest, err := s.versions.Find(ctx, model.BoardID, 0, 1)
+		if err != nil {
+			return nil, err
+		}
+		if len(latest) > 0 {
+			models[i].Version = latest[0].Version
+		}
+	}
+	return models, nil
+}
+
+// snapshot records every board the wrapped Storage wrote, as the write left
+// it, even when the call failed after applying some writes. A failure is
+// only logged, like one of the audit storage: the write itself has already
+// been applied and must not be reported as failed.
+func (s *versionStorage) snapshot(ctx context.Context, changes *Changes) {
+	var versions []BoardVersion
+	for _, change := range changes.List() {
+		if change.After != nil {
+			versions = append(versions, CreateBoardVersion(*change.After))
+		}
+	}
+
+	if len(versions) == 0 {
+		return
+	}
+
+	if err := s.versions.Append(ctx, versions...); err != nil {
+		ids := slice.Map(versions, func(item BoardVersion) string {
+			return item.BoardID
+		})
+		tracing.Logger(ctx, s.log).Error().Err(err).Strs("board_ids", ids).Msg("board versions not appended")
+	}
+}
@@ -0,0 +1,74 @@
+package board
+
+import (
+	"context"
+	"errors"
+	"github.com/rs/zerolog"
+	"testing"
+)
+
+type memoryVersionStorage struct {
+	VersionStorage
+	versions []BoardVersion
+	err      error
+}
+
+func (s *memoryVersionStorage) Append(_ context.Context, versions ...BoardVersion) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.versions = append(s.versions, versions...)
+	return nil
+}
+
+func (s *memoryVersionStorage) Find(context.Context, string, uint64, uint32) ([]BoardVersion, error) {
+	return nil, nil
+}
+
+func TestVersionStorageSnapshotsEveryWrite(t *testing.T) {
+	base := &changingStorage{boards: map[string]Board{
+		"b1": {BoardID: "b1", OwnerID: "o1", Name: "old", Version: 1},
+	}}
+	versions := new(memoryVersionStorage)
+	s := NewVersionStorage(base, versions, zerolog.Nop())
+
+	if _, err := s.SaveMany(context.Background(), []Board{
+		{BoardID: "b1", Name: "first"},
+		{BoardID: "b1", Name: "second"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(versions.versions) != 2 {
+		t.Fatalf("got %d versions, want one per write", len(versions.versions))
+	}
+	for i, want := range []struct {
+		version uint64
+		name    string
+	}{{2, "first"}, {3, "second"}} {
+		if v := versions.versions[i]; v.Version != want.version || v.Board.Name != want.name {
+			t.Fatalf("got version %d named %q, want %d named %q", v.Version, v.Board.Name, want.version, want.name)
+		}
+	}
+
+	versions.err = errors.New("unavailable")
+	if _, err := s.SaveMany(context.Background(), []Board{{BoardID: "b1", Name: "third"}}); err != nil {
+		t.Fatalf("got %v, want the applied write reported as such", err)
+	}
+}
+
+func TestRevertBoardReplacesTheBoard(t *testing.T) {
+	current := Board{BoardID: "b1", OwnerID: "o2", Name: "now", Metadata: "{}", Members: []Member{
+		{MemberID: "m1", Roles: []string{"editor"}},
+		{MemberID: "m2", Roles: []string{"viewer"}},
+	}, Version: 4}
+	version := BoardVersion{BoardID: "b1", Version: 2, Board: Board{BoardID: "b1", OwnerID: "o1", Name: "then", Members: []Member{
+		{MemberID: "m1", Roles: []string{"viewer"}},
+	}}}
+
+	got := Merge(&current, RevertBoard(current, version))
+	if got.OwnerID != "o2" || got.Name != "then" || got.Metadata != "" || len(got.Members) != 1 ||
+		got.Members[0].Roles[0] != "viewer" || got.Version != 5 {
+		t.Fatalf("got %+v, want the name, metadata and members of version 2 at version 5", got)
+	}
+}
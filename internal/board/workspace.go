@@ -0,0 +1,110 @@
+package board
+
+import (
+	"context"
+	"github.com/go-funcards/board-service/proto/v1"
+	"github.com/go-funcards/slice"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+// Workspace groups the boards of a workspace, see Board.WorkspaceID. Its
+// members are members of every board of the workspace, with the roles they
+// have in the workspace unless the board names them itself. Storages only
+// write new boards and moves into existing workspaces, restored boards and
+// boards created before workspaces may still be in one that is missing, then
+// they inherit no members.
+type Workspace struct {
+	WorkspaceID string    `json:"workspace_id" bson:"_id,omitempty"`
+	OwnerID     string    `json:"owner_id" bson:"owner_id,omitempty"`
+	Name        string    `json:"name" bson:"name,omitempty"`
+	CreatedAt   time.Time `json:"created_at" bson:"created_at,omitempty"`
+	Members     []Member  `json:"members" bson:"members,omitempty"`
+}
+
+// WorkspaceFilter matches workspaces by id and owner OR member.
+type WorkspaceFilter struct {
+	WorkspaceIDs []string `json:"workspace_ids,omitempty"`
+	OwnerIDs     []string `json:"owner_ids,omitempty"`
+	MemberIDs    []string `json:"member_ids,omitempty"`
+}
+
+type WorkspaceStorage interface {
+	// Create inserts the workspace, failing with AlreadyExists when there is
+	// one with its id.
+	Create(ctx context.Context, model Workspace) error
+	// Update merges model into the stored workspace like Storage.Save does
+	// boards: empty fields are left untouched, the members being changed are
+	// replaced, the owner and creation time are kept. It fails with NotFound
+	// when there is no such workspace.
+	Update(ctx context.Context, model Workspace) error
+	// Delete fails with Conflict while the workspace has boards, or is being
+	// deleted by another call. It is checked against the boards written
+	// concurrently: they are either counted, or fail with NotFound or
+	// Conflict and are not written.
+	Delete(ctx context.Context, id string) error
+	Find(ctx context.Context, filter WorkspaceFilter, index uint64, size uint32) ([]Workspace, error)
+	Count(ctx context.Context, filter WorkspaceFilter) (uint64, error)
+}
+
+func (w Workspace) toProto() *v1.WorkspacesResponse_Workspace {
+	return &v1.WorkspacesResponse_Workspace{
+		WorkspaceId: w.WorkspaceID,
+		OwnerId:     w.OwnerID,
+		Name:        w.Name,
+		CreatedAt:   timestamppb.New(w.CreatedAt),
+		Members: slice.Map(w.Members, func(m Member) *v1.BoardsResponse_Board_Member {
+			return m.toProto()
+		}),
+	}
+}
+
+// inherit adds to the members of b the members of its workspace it does not
+// name itself.
+func (b Board) inherit(w Workspace) Board {
+	members := slice.Copy(b.Members)
+	for _, m := range w.Members {
+		if !b.hasMember(m.MemberID) {
+			members = append(members, Member{MemberID: m.MemberID, Roles: m.Roles, Inherited: true})
+		}
+	}
+	b.Members = members
+	return b
+}
+
+func CreateWorkspace(in *v1.CreateWorkspaceRequest) Workspace {
+	return Workspace{
+		WorkspaceID: in.GetWorkspaceId(),
+		OwnerID:     in.GetOwnerId(),
+		Name:        in.GetName(),
+		CreatedAt:   time.Now().UTC(),
+		Members: slice.Map(in.GetMembers(), func(item *v1.CreateWorkspaceRequest_Member) Member {
+			return Member{
+				MemberID: item.GetMemberId(),
+				Roles:    item.GetRoles(),
+			}
+		}),
+	}
+}
+
+func UpdateWorkspace(in *v1.UpdateWorkspaceRequest) Workspace {
+	return Workspace{
+		WorkspaceID: in.GetWorkspaceId(),
+		Name:        in.GetName(),
+		Members: slice.Map(in.GetMembers(), func(item *v1.UpdateWorkspaceRequest_Member) Member {
+			return Member{
+				MemberID: item.GetMemberId(),
+				Roles:    item.GetRoles(),
+				Delete:   item.GetDelete(),
+			}
+		}),
+	}
+}
+
+func CreateWorkspaceFilter(in *v1.WorkspacesRequest) WorkspaceFilter {
+	return WorkspaceFilter{
+		WorkspaceIDs: in.GetWorkspaceIds(),
+		OwnerIDs:     in.GetOwnerIds(),
+		MemberIDs:    in.GetMemberIds(),
+	}
+}
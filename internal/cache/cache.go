@@ -0,0 +1,19 @@
+package cache
+
+import "context"
+
+// Cache holds values for a limited time, each backend applying its own TTL.
+type Cache interface {
+	// Get returns the values of the keys that are present.
+	Get(ctx context.Context, keys ...string) (map[string][]byte, error)
+	Set(ctx context.Context, items map[string][]byte) error
+	Delete(ctx context.Context, keys ...string) error
+}
+
+// Events carries invalidated keys between replicas, so caches kept in
+// process do not serve values changed elsewhere.
+type Events interface {
+	Publish(ctx context.Context, keys ...string) error
+	// Subscribe calls fn with the keys published by any replica until ctx is done.
+	Subscribe(ctx context.Context, fn func(keys []string))
+}
@@ -0,0 +1,43 @@
+package cache
+
+import (
+	"context"
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	"time"
+)
+
+var _ Cache = (*memory)(nil)
+
+type memory struct {
+	lru *expirable.LRU[string, []byte]
+}
+
+// NewMemory keeps up to size values in process, evicting the least recently
+// used ones first and any older than ttl.
+func NewMemory(size int, ttl time.Duration) *memory {
+	return &memory{lru: expirable.NewLRU[string, []byte](size, nil, ttl)}
+}
+
+func (c *memory) Get(_ context.Context, keys ...string) (map[string][]byte, error) {
+	values := make(map[string][]byte, len(keys))
+	for _, key := range keys {
+		if value, ok := c.lru.Get(key); ok {
+			values[key] = value
+		}
+	}
+	return values, nil
+}
+
+func (c *memory) Set(_ context.Context, items map[string][]byte) error {
+	for key, value := range items {
+		c.lru.Add(key, value)
+	}
+	return nil
+}
+
+func (c *memory) Delete(_ context.Context, keys ...string) error {
+	for _, key := range keys {
+		c.lru.Remove(key)
+	}
+	return nil
+}
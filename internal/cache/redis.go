@@ -0,0 +1,131 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog"
+	"time"
+)
+
+var (
+	_ Cache  = (*redisCache)(nil)
+	_ Events = (*redisEvents)(nil)
+)
+
+type redisCache struct {
+	client *redis.Client
+	prefix string
+	ttl    time.Duration
+}
+
+// NewRedis keeps values under prefix in Redis, shared by all replicas.
+func NewRedis(client *redis.Client, prefix string, ttl time.Duration) *redisCache {
+	return &redisCache{
+		client: client,
+		prefix: prefix,
+		ttl:    ttl,
+	}
+}
+
+func (c *redisCache) keys(keys []string) []string {
+	prefixed := make([]string, len(keys))
+	for i, key := range keys {
+		prefixed[i] = c.prefix + key
+	}
+	return prefixed
+}
+
+func (c *redisCache) Get(ctx context.Context, keys ...string) (map[string][]byte, error) {
+	values := make(map[string][]byte, len(keys))
+	if len(keys) == 0 {
+		return values, nil
+	}
+
+	data, err := c.client.MGet(ctx, c.keys(keys)...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	for i, value := range data {
+		if s, ok := value.(string); ok {
+			values[keys[i]] = []byte(s)
+		}
+	}
+	return values, nil
+}
+
+func (c *redisCache) Set(ctx context.Context, items map[string][]byte) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	_, err := c.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for key, value := range items {
+			pipe.Set(ctx, c.prefix+key, value, c.ttl)
+		}
+		return nil
+	})
+	return err
+}
+
+func (c *redisCache) Delete(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	return c.client.Del(ctx, c.keys(keys)...).Err()
+}
+
+type redisEvents struct {
+	client  *redis.Client
+	channel string
+	log     zerolog.Logger
+}
+
+// NewRedisEvents publishes invalidated keys as JSON arrays on channel.
+func NewRedisEvents(client *redis.Client, channel string, log zerolog.Logger) *redisEvents {
+	return &redisEvents{
+		client:  client,
+		channel: channel,
+		log:     log.With().Str("component", "cache").Str("channel", channel).Logger(),
+	}
+}
+
+func (e *redisEvents) Publish(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	data, err := json.Marshal(keys)
+	if err != nil {
+		return err
+	}
+	return e.client.Publish(ctx, e.channel, data).Err()
+}
+
+func (e *redisEvents) Subscribe(ctx context.Context, fn func(keys []string)) {
+	sub := e.client.Subscribe(ctx, e.channel)
+	defer func() {
+		_ = sub.Close()
+	}()
+
+	// the channel of go-redis resubscribes after connection losses by itself
+	messages := sub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-messages:
+			if !ok {
+				return
+			}
+
+			var keys []string
+			if err := json.Unmarshal([]byte(msg.Payload), &keys); err != nil {
+				e.log.Warn().Err(err).Msg("malformed invalidation event")
+				continue
+			}
+			fn(keys)
+		}
+	}
+}
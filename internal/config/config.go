@@ -1,19 +1,107 @@
 package config
 
 import (
+	"github.com/go-funcards/board-service/internal/board"
+	"github.com/go-funcards/board-service/internal/ratelimit"
 	"github.com/go-funcards/validate"
 	"github.com/ilyakaznacheev/cleanenv"
 	"github.com/rs/zerolog"
 	"sync"
+	"time"
 )
 
 type Config struct {
+	Log struct {
+		Level string `yaml:"level" env:"LEVEL"`
+	} `yaml:"log" env-prefix:"LOG_"`
+	Reload struct {
+		Interval time.Duration `yaml:"interval" env:"INTERVAL"`
+	} `yaml:"reload" env-prefix:"RELOAD_"`
 	MongoDB struct {
-		URI string `yaml:"uri" env:"URI" env-required:"true"`
+		URI string `yaml:"uri" env:"URI"`
 	} `yaml:"mongodb" env-prefix:"MONGODB_"`
 	GRPC struct {
-		Addr string `yaml:"address" env:"ADDR" env-default:":80"`
+		Addr            string        `yaml:"address" env:"ADDR" env-default:":80"`
+		HealthCheck     bool          `yaml:"health_check" env:"HEALTH_CHECK"`
+		HealthInterval  time.Duration `yaml:"health_interval" env:"HEALTH_INTERVAL" env-default:"10s"`
+		Reflection      bool          `yaml:"reflection" env:"REFLECTION"`
+		DrainDelay      time.Duration `yaml:"drain_delay" env:"DRAIN_DELAY"`
+		ShutdownTimeout time.Duration `yaml:"shutdown_timeout" env:"SHUTDOWN_TIMEOUT" env-default:"30s"`
 	} `yaml:"grpc" env-prefix:"GRPC_"`
+	Gateway struct {
+		Addr string `yaml:"address" env:"ADDR"`
+	} `yaml:"gateway" env-prefix:"GATEWAY_"`
+	Metrics struct {
+		Addr          string        `yaml:"address" env:"ADDR"`
+		StatsInterval time.Duration `yaml:"stats_interval" env:"STATS_INTERVAL" env-default:"1m"`
+	} `yaml:"metrics" env-prefix:"METRICS_"`
+	Tracing struct {
+		Exporter    string  `yaml:"exporter" env:"EXPORTER" env-default:"none"`
+		Endpoint    string  `yaml:"endpoint" env:"ENDPOINT" env-default:"localhost:4317"`
+		Insecure    bool    `yaml:"insecure" env:"INSECURE"`
+		SampleRatio float64 `yaml:"sample_ratio" env:"SAMPLE_RATIO" env-default:"1"`
+	} `yaml:"tracing" env-prefix:"TRACING_"`
+	Postgres struct {
+		DSN string `yaml:"dsn" env:"DSN"`
+	} `yaml:"postgres" env-prefix:"POSTGRES_"`
+	Bolt struct {
+		Path string `yaml:"path" env:"PATH" env-default:"data/boards.db"`
+	} `yaml:"bolt" env-prefix:"BOLT_"`
+	Redis struct {
+		Addr     string `yaml:"addr" env:"ADDR"`
+		Password string `yaml:"password" env:"PASSWORD"`
+		DB       int    `yaml:"db" env:"DB"`
+	} `yaml:"redis" env-prefix:"REDIS_"`
+	Storage struct {
+		Driver      string `yaml:"driver" env:"DRIVER" env-default:"mongodb"`
+		AutoMigrate bool   `yaml:"auto_migrate" env:"AUTO_MIGRATE"`
+		Indexes     struct {
+			DropUnexpected bool `yaml:"drop_unexpected" env:"DROP_UNEXPECTED"`
+		} `yaml:"indexes" env-prefix:"INDEXES_"`
+		Timeout  time.Duration            `yaml:"timeout" env:"TIMEOUT" env-default:"5s"`
+		Timeouts map[string]time.Duration `yaml:"timeouts"`
+		Retry    struct {
+			MaxAttempts    int           `yaml:"max_attempts" env:"MAX_ATTEMPTS"`
+			InitialBackoff time.Duration `yaml:"initial_backoff" env:"INITIAL_BACKOFF" env-default:"50ms"`
+			MaxBackoff     time.Duration `yaml:"max_backoff" env:"MAX_BACKOFF" env-default:"1s"`
+		} `yaml:"retry" env-prefix:"RETRY_"`
+	} `yaml:"storage" env-prefix:"STORAGE_"`
+	Cache struct {
+		Enabled         bool          `yaml:"enabled" env:"ENABLED"`
+		Backend         string        `yaml:"backend" env:"BACKEND" env-default:"memory"`
+		Size            int           `yaml:"size" env:"SIZE" env-default:"10000"`
+		TTL             time.Duration `yaml:"ttl" env:"TTL" env-default:"1m"`
+		MaxMemberBoards uint32        `yaml:"max_member_boards" env:"MAX_MEMBER_BOARDS" env-default:"1000"`
+		Prefix          string        `yaml:"prefix" env:"PREFIX" env-default:"board-service:"`
+		Channel         string        `yaml:"channel" env:"CHANNEL" env-default:"board-service:invalidate"`
+	} `yaml:"cache" env-prefix:"CACHE_"`
+	History struct {
+		MaxVersions uint64        `yaml:"max_versions" env:"MAX_VERSIONS"`
+		MaxAge      time.Duration `yaml:"max_age" env:"MAX_AGE"`
+	} `yaml:"history" env-prefix:"HISTORY_"`
+	Idempotency struct {
+		TTL   time.Duration `yaml:"ttl" env:"TTL" env-default:"24h"`
+		Lease time.Duration `yaml:"lease" env:"LEASE" env-default:"1m"`
+	} `yaml:"idempotency" env-prefix:"IDEMPOTENCY_"`
+	Quotas struct {
+		Default board.Limits `yaml:"default"`
+	} `yaml:"quotas" env-prefix:"QUOTAS_"`
+	Tenancy struct {
+		Required bool   `yaml:"required" env:"REQUIRED"`
+		Claim    string `yaml:"claim" env:"CLAIM" env-default:"workspace_id"`
+	} `yaml:"tenancy" env-prefix:"TENANCY_"`
+	Auth struct {
+		JWTSecret    string `yaml:"jwt_secret" env:"JWT_SECRET"`
+		JWTPublicKey string `yaml:"jwt_public_key" env:"JWT_PUBLIC_KEY"`
+	} `yaml:"auth" env-prefix:"AUTH_"`
+	RateLimit struct {
+		Enabled     bool                       `yaml:"enabled" env:"ENABLED"`
+		Distributed bool                       `yaml:"distributed" env:"DISTRIBUTED"`
+		Idle        time.Duration              `yaml:"idle" env:"IDLE" env-default:"10m"`
+		Header      string                     `yaml:"header" env:"HEADER" env-default:"x-caller-id"`
+		Default     ratelimit.Limit            `yaml:"default"`
+		Methods     map[string]ratelimit.Limit `yaml:"methods"`
+	} `yaml:"rate_limit" env-prefix:"RATE_LIMIT_"`
 	Validation struct {
 		Rules validate.TypeRules `yaml:"rules" env:"RULES"`
 	} `yaml:"validation" env-prefix:"VALIDATION_"`
@@ -28,9 +116,17 @@ func GetConfig(path string, log zerolog.Logger) Config {
 	once.Do(func() {
 		log.Debug().Msgf("read config from path %s", path)
 
-		if err := cleanenv.ReadConfig(path, &cfg); err != nil {
+		var err error
+		if cfg, err = Load(path); err != nil {
 			log.Fatal().Err(err).Msg("")
 		}
 	})
 	return cfg
 }
+
+// Load reads the config at path, overridden by the environment.
+func Load(path string) (Config, error) {
+	var c Config
+	err := cleanenv.ReadConfig(path, &c)
+	return c, err
+}
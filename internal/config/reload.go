@@ -0,0 +1,81 @@
+package config
+
+import (
+	"context"
+	"crypto/sha256"
+	"github.com/go-funcards/board-service/internal/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/rs/zerolog"
+	"os"
+	"time"
+)
+
+var reloads = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: metrics.Namespace,
+	Subsystem: "config",
+	Name:      "reloads_total",
+	Help:      "Total number of config reloads, by result.",
+}, []string{"result"})
+
+// Watch checks the config at path every interval, unless it is 0, and passes
+// it to apply whenever the file content changes, or at once on a value from
+// reload, like SIGHUP, whether it changed or not. A config which can't be
+// read, or which apply rejects, is logged and the current one stays in
+// effect. Comparing the content rather than the modification time also
+// catches files swapped through symlinks, as mounted config maps are.
+func Watch(ctx context.Context, path string, interval time.Duration, reload <-chan os.Signal, log zerolog.Logger, apply func(Config) error) {
+	last, err := checksum(path)
+	if err != nil {
+		log.Error().Err(err).Msg("config not readable")
+	}
+
+	var tick <-chan time.Time
+	if interval > 0 {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	for {
+		forced := false
+		select {
+		case <-ctx.Done():
+			return
+		case <-tick:
+		case <-reload:
+			forced = true
+		}
+
+		sum, err := checksum(path)
+		if err != nil {
+			log.Error().Err(err).Msg("config not readable")
+			continue
+		}
+		if sum == last && !forced {
+			continue
+		}
+		last = sum
+
+		c, err := Load(path)
+		if err == nil {
+			err = apply(c)
+		}
+		if err != nil {
+			reloads.WithLabelValues("failure").Inc()
+			log.Error().Err(err).Msg("config reload rejected, keeping the current config")
+			continue
+		}
+
+		reloads.WithLabelValues("success").Inc()
+		log.Info().Msg("config reloaded")
+	}
+}
+
+func checksum(path string) ([sha256.Size]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return [sha256.Size]byte{}, err
+	}
+	return sha256.Sum256(data), nil
+}
@@ -0,0 +1,88 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"github.com/go-funcards/board-service/internal/board"
+	"github.com/go-funcards/board-service/internal/idempotency"
+	"github.com/go-funcards/board-service/proto/v1"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Serve translates HTTP/JSON requests on addr into calls to the gRPC server
+// listening on endpoint, until ctx is done. Like the gRPC server it then keeps
+// serving for drain, stops accepting requests and waits up to timeout for the
+// in-flight ones, whose calls to endpoint go through until they are done.
+// headers are forwarded as metadata in addition to the ones the service
+// always reads.
+func Serve(ctx context.Context, addr, endpoint string, drain, timeout time.Duration, log zerolog.Logger, headers ...string) {
+	mux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(headerMatcher(headers)))
+
+	// the connection to endpoint is closed when connCtx is done, so it must
+	// outlive the requests still being served after ctx is
+	connCtx, closeConn := context.WithCancel(context.Background())
+	defer closeConn()
+
+	err := v1.RegisterBoardHandlerFromEndpoint(connCtx, mux, endpoint, []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	})
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to register gateway handler")
+	}
+
+	srv := &http.Server{Addr: addr, Handler: mux, ReadHeaderTimeout: 5 * time.Second}
+
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		<-ctx.Done()
+
+		if drain > 0 {
+			log.Info().Dur("drain", drain).Msg("draining gateway...")
+			time.Sleep(drain)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+
+		if err := srv.Shutdown(ctx); err != nil {
+			log.Error().Err(err).Msg("gateway shutdown grace period elapsed, force close")
+			_ = srv.Close()
+		}
+	}()
+
+	log.Info().Msgf("bind gateway to addr: %s", addr)
+
+	if err = srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		log.Fatal().Err(err).Msg("failed to serve gateway")
+	}
+
+	<-stopped
+}
+
+// headerMatcher forwards the headers the gRPC interceptors and server read
+// as they are, next to the ones forwarded by default.
+func headerMatcher(headers []string) runtime.HeaderMatcherFunc {
+	forward := map[string]bool{
+		idempotency.MetadataKey:   true,
+		board.MetadataActorID:     true,
+		board.MetadataRequestID:   true,
+		board.MetadataWorkspaceID: true,
+	}
+	for _, header := range headers {
+		forward[strings.ToLower(header)] = true
+	}
+
+	return func(key string) (string, bool) {
+		if key = strings.ToLower(key); forward[key] {
+			return key, true
+		}
+		return runtime.DefaultHeaderMatcher(key)
+	}
+}
@@ -0,0 +1,75 @@
+package healthcheck
+
+import (
+	"context"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"time"
+)
+
+// Ping reports whether a dependency of the service is reachable.
+type Ping func(ctx context.Context) error
+
+// Checker keeps the serving status of the health server in line with the
+// reachability of the dependencies of the service, pinging them every interval.
+type Checker struct {
+	srv      *health.Server
+	pings    map[string]Ping
+	interval time.Duration
+	timeout  time.Duration
+	services []string
+	log      zerolog.Logger
+}
+
+func NewChecker(srv *health.Server, pings map[string]Ping, interval time.Duration, log zerolog.Logger, services ...string) *Checker {
+	return &Checker{
+		srv:      srv,
+		pings:    pings,
+		interval: interval,
+		timeout:  interval / 2,
+		services: append([]string{""}, services...),
+		log:      log.With().Str("component", "healthcheck").Logger(),
+	}
+}
+
+// Run checks the dependencies until ctx is done.
+func (c *Checker) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	last := grpc_health_v1.HealthCheckResponse_UNKNOWN
+	for {
+		if current := c.check(ctx); current != last {
+			c.log.Info().Str("status", current.String()).Msg("serving status changed")
+			for _, service := range c.services {
+				c.srv.SetServingStatus(service, current)
+			}
+			last = current
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Shutdown reports NOT_SERVING for all services from now on.
+func (c *Checker) Shutdown() {
+	c.srv.Shutdown()
+}
+
+func (c *Checker) check(ctx context.Context) grpc_health_v1.HealthCheckResponse_ServingStatus {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	for name, ping := range c.pings {
+		if err := ping(ctx); err != nil {
+			c.log.Warn().Err(err).Msgf("%s ping failed", name)
+			return grpc_health_v1.HealthCheckResponse_NOT_SERVING
+		}
+	}
+	return grpc_health_v1.HealthCheckResponse_SERVING
+}
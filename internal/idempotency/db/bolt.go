@@ -0,0 +1,170 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/go-funcards/board-service/internal/idempotency"
+	"github.com/rs/zerolog"
+	bolt "go.etcd.io/bbolt"
+	"time"
+)
+
+var _ idempotency.Storage = (*boltStorage)(nil)
+
+var bucket = []byte(Collection)
+
+type boltStorage struct {
+	db  *bolt.DB
+	ttl time.Duration
+	log zerolog.Logger
+}
+
+// NewBoltStorage keeps idempotency keys in the bucket of the same name as the
+// MongoDB collection. Keys older than ttl are treated as absent and purged in
+// the background until ctx is done.
+func NewBoltStorage(ctx context.Context, db *bolt.DB, ttl time.Duration, log zerolog.Logger) *boltStorage {
+	s := &boltStorage{
+		db:  db,
+		ttl: ttl,
+		log: log.With().Str("storage", "bolt").Str("bucket", Collection).Logger(),
+	}
+
+	err := db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(bucket)
+		return err
+	})
+	if err != nil {
+		s.log.Fatal().Err(err).Msg("bucket not created")
+	}
+
+	go s.purge(ctx)
+
+	return s
+}
+
+func (s *boltStorage) purge(ctx context.Context) {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		var purged int
+		err := s.db.Update(func(tx *bolt.Tx) error {
+			c := tx.Bucket(bucket).Cursor()
+			for k, v := c.First(); k != nil; k, v = c.Next() {
+				record, err := s.decode(v)
+				if err != nil {
+					return err
+				}
+				if s.expired(record) {
+					if err = c.Delete(); err != nil {
+						return err
+					}
+					purged++
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			s.log.Error().Err(err).Msg("failed to purge expired idempotency keys")
+			continue
+		}
+		if purged > 0 {
+			s.log.Debug().Int("count", purged).Msg("expired idempotency keys purged")
+		}
+	}
+}
+
+func (s *boltStorage) expired(record idempotency.Record) bool {
+	return s.ttl > 0 && time.Since(record.CreatedAt) > s.ttl
+}
+
+func (s *boltStorage) decode(data []byte) (idempotency.Record, error) {
+	var record idempotency.Record
+	err := json.Unmarshal(data, &record)
+	return record, err
+}
+
+func (s *boltStorage) Acquire(_ context.Context, record idempotency.Record) (idempotency.Record, bool, error) {
+	existing, acquired := record, true
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(bucket)
+		if data := b.Get([]byte(record.ID)); data != nil {
+			current, err := s.decode(data)
+			if err != nil {
+				return err
+			}
+			// a record in progress past its lease was left by a lost call
+			if !s.expired(current) && current.Leased(record.CreatedAt) {
+				existing, acquired = current, false
+				return nil
+			}
+		}
+
+		data, err := json.Marshal(record)
+		if err != nil {
+			return err
+		}
+		return b.Put([]byte(record.ID), data)
+	})
+	if err != nil {
+		return record, false, err
+	}
+
+	if acquired {
+		s.log.Debug().Str("key", record.Key).Str("method", record.Method).Msg("idempotency key acquired")
+	} else {
+		s.log.Debug().Str("key", record.Key).Bool("done", existing.Done).Msg("idempotency key exists")
+	}
+
+	return existing, acquired, nil
+}
+
+func (s *boltStorage) Complete(_ context.Context, record idempotency.Record, response []byte) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(bucket)
+		current, held, err := s.held(b, record)
+		if err != nil || !held {
+			return err
+		}
+		current.Response = response
+		current.Done = true
+
+		data, err := json.Marshal(current)
+		if err != nil {
+			return err
+		}
+		return b.Put([]byte(record.ID), data)
+	})
+}
+
+func (s *boltStorage) Release(_ context.Context, record idempotency.Record) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(bucket)
+		_, held, err := s.held(b, record)
+		if err != nil || !held {
+			return err
+		}
+		return b.Delete([]byte(record.ID))
+	})
+}
+
+// held returns the stored record while it is in progress and not taken over
+// by another call.
+func (s *boltStorage) held(b *bolt.Bucket, record idempotency.Record) (idempotency.Record, bool, error) {
+	data := b.Get([]byte(record.ID))
+	if data == nil {
+		return record, false, nil
+	}
+
+	current, err := s.decode(data)
+	if err != nil {
+		return record, false, err
+	}
+	return current, !current.Done && current.CreatedAt.Equal(record.CreatedAt), nil
+}
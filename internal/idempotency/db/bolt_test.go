@@ -0,0 +1,67 @@
+package db
+
+import (
+	"context"
+	boltdb "github.com/go-funcards/board-service/internal/board/db/bolt"
+	"github.com/go-funcards/board-service/internal/idempotency"
+	"github.com/rs/zerolog"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestBoltStorageIgnoresTheCallWhoseLeaseWasTakenOver(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	log := zerolog.Nop()
+	db := boltdb.Open(filepath.Join(t.TempDir(), "boards.db"), log)
+	t.Cleanup(func() { _ = db.Close() })
+	s := NewBoltStorage(ctx, db, time.Hour, log)
+
+	lost, err := idempotency.CreateRecord("caller", "k1", "/test.Service/Create", nil, time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, acquired, err := s.Acquire(ctx, lost); err != nil || !acquired {
+		t.Fatalf("got acquired %v (%v), want the key", acquired, err)
+	}
+
+	time.Sleep(5 * time.Millisecond)
+
+	owner, err := idempotency.CreateRecord("caller", "k1", "/test.Service/Create", nil, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, acquired, err := s.Acquire(ctx, owner); err != nil || !acquired {
+		t.Fatalf("got acquired %v (%v), want the key taken over", acquired, err)
+	}
+
+	// the lost call comes back late: neither its failure nor its response
+	// may touch the record of the new owner
+	if err = s.Release(ctx, lost); err != nil {
+		t.Fatal(err)
+	}
+	if err = s.Complete(ctx, lost, []byte("stale")); err != nil {
+		t.Fatal(err)
+	}
+
+	retry, err := idempotency.CreateRecord("caller", "k1", "/test.Service/Create", nil, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	existing, acquired, err := s.Acquire(ctx, retry)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if acquired || existing.Done || !existing.CreatedAt.Equal(owner.CreatedAt) {
+		t.Fatalf("got %+v (acquired %v), want the new owner still in progress", existing, acquired)
+	}
+
+	if err = s.Complete(ctx, owner, []byte("done")); err != nil {
+		t.Fatal(err)
+	}
+	if existing, _, _ = s.Acquire(ctx, retry); !existing.Done || string(existing.Response) != "done" {
+		t.Fatalf("got %+v, want the response of the new owner", existing)
+	}
+}
@@ -0,0 +1,105 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-funcards/board-service/internal/idempotency"
+	"github.com/go-funcards/mongodb"
+	"github.com/rs/zerolog"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"time"
+)
+
+var _ idempotency.Storage = (*storage)(nil)
+
+// Collection holds the idempotency keys, and names the bolt bucket and the
+// postgres table of the other drivers. Keys expire through the TTL index
+// declared with the board indexes.
+const Collection = "idempotency_keys"
+
+type storage struct {
+	c       *mongo.Collection
+	timeout time.Duration
+	log     zerolog.Logger
+}
+
+func NewStorage(db *mongo.Database, timeout time.Duration, log zerolog.Logger) *storage {
+	if timeout <= 0 {
+		timeout = 5 * time.Second
+	}
+	return &storage{
+		c:       db.Collection(Collection),
+		timeout: timeout,
+		log:     log.With().Str("storage", "mongodb").Str("collection", Collection).Logger(),
+	}
+}
+
+func (s *storage) Acquire(ctx context.Context, record idempotency.Record) (idempotency.Record, bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	_, err := s.c.InsertOne(ctx, record)
+	if err == nil {
+		s.log.Debug().Str("key", record.Key).Str("method", record.Method).Msg("idempotency key acquired")
+		return record, true, nil
+	}
+	if !mongo.IsDuplicateKeyError(err) {
+		return record, false, fmt.Errorf(mongodb.ErrMsgQuery, err)
+	}
+
+	// a record in progress past its lease was left by a lost call
+	err = s.c.FindOneAndReplace(ctx, bson.M{
+		"_id":          record.ID,
+		"done":         false,
+		"leased_until": bson.M{"$lt": record.CreatedAt},
+	}, record).Err()
+	if err == nil {
+		s.log.Info().Str("key", record.Key).Str("method", record.Method).Msg("idempotency key taken over")
+		return record, true, nil
+	}
+	if !errors.Is(err, mongo.ErrNoDocuments) {
+		return record, false, fmt.Errorf(mongodb.ErrMsgQuery, err)
+	}
+
+	existing, err := mongodb.DecodeOne[idempotency.Record](s.c.FindOne(ctx, bson.M{"_id": record.ID}))
+	if err != nil {
+		return record, false, err
+	}
+
+	s.log.Debug().Str("key", record.Key).Bool("done", existing.Done).Msg("idempotency key exists")
+
+	return existing, false, nil
+}
+
+func (s *storage) Complete(ctx context.Context, record idempotency.Record, response []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	_, err := s.c.UpdateOne(ctx, held(record), bson.M{
+		"$set": bson.M{
+			"response": response,
+			"done":     true,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf(mongodb.ErrMsgQuery, err)
+	}
+	return nil
+}
+
+func (s *storage) Release(ctx context.Context, record idempotency.Record) error {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	if _, err := s.c.DeleteOne(ctx, held(record)); err != nil {
+		return fmt.Errorf(mongodb.ErrMsgQuery, err)
+	}
+	return nil
+}
+
+// held matches record while in progress and not taken over by another call.
+func held(record idempotency.Record) bson.M {
+	return bson.M{"_id": record.ID, "done": false, "created_at": record.CreatedAt}
+}
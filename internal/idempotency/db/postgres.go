@@ -0,0 +1,143 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-funcards/board-service/internal/idempotency"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/rs/zerolog"
+	"time"
+)
+
+var _ idempotency.Storage = (*postgresStorage)(nil)
+
+// errMsgQuery is postgres.ErrMsgQuery, which cannot be imported here: the
+// postgres storages import the mongodb ones, which name Collection.
+const errMsgQuery = "failed to execute query due to error: %w"
+
+type postgresStorage struct {
+	pool    *pgxpool.Pool
+	ttl     time.Duration
+	timeout time.Duration
+	log     zerolog.Logger
+}
+
+// NewPostgresStorage keeps idempotency keys in the table of the same name as
+// the MongoDB collection, created by the board migrations. Keys older than ttl
+// are treated as absent and purged in the background until ctx is done.
+func NewPostgresStorage(ctx context.Context, pool *pgxpool.Pool, ttl, timeout time.Duration, log zerolog.Logger) *postgresStorage {
+	if timeout <= 0 {
+		timeout = 5 * time.Second
+	}
+	s := &postgresStorage{
+		pool:    pool,
+		ttl:     ttl,
+		timeout: timeout,
+		log:     log.With().Str("storage", "postgres").Str("table", Collection).Logger(),
+	}
+
+	go s.purge(ctx)
+
+	return s
+}
+
+func (s *postgresStorage) purge(ctx context.Context) {
+	if s.ttl <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		purgeCtx, cancel := context.WithTimeout(ctx, s.timeout)
+		tag, err := s.pool.Exec(purgeCtx, "DELETE FROM idempotency_keys WHERE created_at < $1", time.Now().Add(-s.ttl))
+		cancel()
+		if err != nil {
+			s.log.Error().Err(err).Msg("failed to purge expired idempotency keys")
+			continue
+		}
+		if purged := tag.RowsAffected(); purged > 0 {
+			s.log.Debug().Int64("count", purged).Msg("expired idempotency keys purged")
+		}
+	}
+}
+
+// Acquire inserts record, or replaces a record which expired or was left in
+// progress past its lease by a lost call.
+func (s *postgresStorage) Acquire(ctx context.Context, record idempotency.Record) (idempotency.Record, bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	var expired time.Time
+	if s.ttl > 0 {
+		expired = record.CreatedAt.Add(-s.ttl)
+	}
+
+	tag, err := s.pool.Exec(ctx, `
+		INSERT INTO idempotency_keys (id, key, method, fingerprint, done, created_at, leased_until)
+		VALUES ($1, $2, $3, $4, FALSE, $5, $6)
+		ON CONFLICT (id) DO UPDATE SET
+			key = EXCLUDED.key,
+			method = EXCLUDED.method,
+			fingerprint = EXCLUDED.fingerprint,
+			response = NULL,
+			done = FALSE,
+			created_at = EXCLUDED.created_at,
+			leased_until = EXCLUDED.leased_until
+		WHERE idempotency_keys.created_at < $7
+			OR (NOT idempotency_keys.done AND idempotency_keys.leased_until < EXCLUDED.created_at)`,
+		record.ID, record.Key, record.Method, record.Fingerprint, record.CreatedAt, record.LeasedUntil, expired,
+	)
+	if err != nil {
+		return record, false, fmt.Errorf(errMsgQuery, err)
+	}
+	if tag.RowsAffected() > 0 {
+		s.log.Debug().Str("key", record.Key).Str("method", record.Method).Msg("idempotency key acquired")
+		return record, true, nil
+	}
+
+	var existing idempotency.Record
+	err = s.pool.QueryRow(ctx, `
+		SELECT id, key, method, fingerprint, response, done, created_at, leased_until
+		FROM idempotency_keys WHERE id = $1`, record.ID,
+	).Scan(&existing.ID, &existing.Key, &existing.Method, &existing.Fingerprint, &existing.Response,
+		&existing.Done, &existing.CreatedAt, &existing.LeasedUntil)
+	if err != nil {
+		return record, false, fmt.Errorf(errMsgQuery, err)
+	}
+
+	s.log.Debug().Str("key", record.Key).Bool("done", existing.Done).Msg("idempotency key exists")
+
+	return existing, false, nil
+}
+
+func (s *postgresStorage) Complete(ctx context.Context, record idempotency.Record, response []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	_, err := s.pool.Exec(ctx, `
+		UPDATE idempotency_keys SET response = $3, done = TRUE
+		WHERE id = $1 AND NOT done AND created_at = $2`, record.ID, record.CreatedAt, response)
+	if err != nil {
+		return fmt.Errorf(errMsgQuery, err)
+	}
+	return nil
+}
+
+func (s *postgresStorage) Release(ctx context.Context, record idempotency.Record) error {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	_, err := s.pool.Exec(ctx, "DELETE FROM idempotency_keys WHERE id = $1 AND NOT done AND created_at = $2", record.ID, record.CreatedAt)
+	if err != nil {
+		return fmt.Errorf(errMsgQuery, err)
+	}
+	return nil
+}
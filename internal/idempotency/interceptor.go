@@ -0,0 +1,132 @@
+package idempotency
+
+import (
+	"context"
+	"github.com/go-funcards/board-service/internal/tracing"
+	"github.com/go-funcards/slice"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"time"
+)
+
+const MetadataKey = "idempotency-key"
+
+// Scope names the caller a key belongs to. Keys of different scopes never
+// meet, even when they are equal.
+type Scope func(ctx context.Context) string
+
+// UnaryServerInterceptor replays the stored response of methods called again
+// by the same caller with the same idempotency key and payload. Only
+// successful responses are recorded, a failed call releases its key so the
+// client can retry it. A call lost with its process holds its key for lease,
+// retries are told it is in progress until then.
+func UnaryServerInterceptor(storage Storage, scope Scope, lease time.Duration, log zerolog.Logger, methods ...string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		key := fromContext(ctx)
+		if len(key) == 0 || !slice.Contains(methods, info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		record, err := CreateRecord(scope(ctx), key, info.FullMethod, req, lease)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		existing, acquired, err := storage.Acquire(ctx, record)
+		if err != nil {
+			return nil, err
+		}
+
+		if !acquired {
+			return replay(existing, record)
+		}
+
+		res, err := handler(ctx, req)
+		if err != nil {
+			if err1 := settle(lease, func(ctx context.Context) error { return storage.Release(ctx, record) }); err1 != nil {
+				tracing.Logger(ctx, log).Error().Err(err1).Str("key", key).Msg("failed to release idempotency key")
+			}
+			return nil, err
+		}
+
+		data, err := marshal(res)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		// the call was applied: failing it now would have the client retry a
+		// change that is done, while the key only stays in progress until its
+		// lease ends
+		if err = settle(lease, func(ctx context.Context) error { return storage.Complete(ctx, record, data) }); err != nil {
+			tracing.Logger(ctx, log).Error().Err(err).Str("key", key).Msg("failed to complete idempotency key")
+		}
+
+		return res, nil
+	}
+}
+
+// settle completes or releases a key apart from the context of its call, so
+// that a client going away once the call is over does not leave the key in
+// progress. It gives up with the lease, when the key is free to be retried
+// anyway; the storages bound their calls too.
+func settle(lease time.Duration, fn func(ctx context.Context) error) error {
+	if lease <= 0 {
+		return fn(context.Background())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), lease)
+	defer cancel()
+
+	return fn(ctx)
+}
+
+func replay(existing, record Record) (any, error) {
+	if existing.Fingerprint != record.Fingerprint {
+		return nil, status.Errorf(codes.InvalidArgument, "%s %q was already used with a different request", MetadataKey, record.Key)
+	}
+	if !existing.Done {
+		return nil, status.Errorf(codes.Aborted, "request with %s %q is still in progress", MetadataKey, record.Key)
+	}
+
+	var a anypb.Any
+	if err := proto.Unmarshal(existing.Response, &a); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res, err := a.UnmarshalNew()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return res, nil
+}
+
+func marshal(res any) ([]byte, error) {
+	m, ok := res.(proto.Message)
+	if !ok {
+		return nil, nil
+	}
+
+	a, err := anypb.New(m)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(a)
+}
+
+func fromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if values := md.Get(MetadataKey); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
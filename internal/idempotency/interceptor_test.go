@@ -0,0 +1,91 @@
+package idempotency
+
+import (
+	"context"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"sync"
+	"testing"
+	"time"
+)
+
+// contextStorage keeps one record and, like the real storages, fails calls
+// made on a context which is done.
+type contextStorage struct {
+	mu     sync.Mutex
+	record *Record
+}
+
+func (s *contextStorage) Acquire(ctx context.Context, record Record) (Record, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := ctx.Err(); err != nil {
+		return record, false, err
+	}
+	if s.record != nil && s.record.Leased(record.CreatedAt) {
+		return *s.record, false, nil
+	}
+	s.record = &record
+	return record, true, nil
+}
+
+func (s *contextStorage) Complete(ctx context.Context, record Record, response []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if s.record != nil && s.record.CreatedAt.Equal(record.CreatedAt) {
+		s.record.Response = response
+		s.record.Done = true
+	}
+	return nil
+}
+
+func (s *contextStorage) Release(ctx context.Context, record Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if s.record != nil && !s.record.Done && s.record.CreatedAt.Equal(record.CreatedAt) {
+		s.record = nil
+	}
+	return nil
+}
+
+func TestInterceptorCompletesAfterTheClientGoesAway(t *testing.T) {
+	const method = "/test.Service/Create"
+
+	storage := new(contextStorage)
+	scope := func(context.Context) string { return "caller" }
+	interceptor := UnaryServerInterceptor(storage, scope, time.Minute, zerolog.Nop(), method)
+	info := &grpc.UnaryServerInfo{FullMethod: method}
+
+	ctx, cancel := context.WithCancel(metadata.NewIncomingContext(context.Background(), metadata.Pairs(MetadataKey, "k1")))
+	calls := 0
+	handler := func(context.Context, any) (any, error) {
+		calls++
+		// the change is applied, then the client gives up on the response
+		cancel()
+		return wrapperspb.String("created"), nil
+	}
+
+	if _, err := interceptor(ctx, wrapperspb.String("req"), info, handler); err != nil {
+		t.Fatal(err)
+	}
+	if storage.record == nil || !storage.record.Done {
+		t.Fatalf("got record %+v, want it completed", storage.record)
+	}
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(MetadataKey, "k1"))
+	res, err := interceptor(ctx, wrapperspb.String("req"), info, handler)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := res.(*wrapperspb.StringValue).GetValue(); got != "created" || calls != 1 {
+		t.Fatalf("got %q after %d calls, want the response replayed after 1", got, calls)
+	}
+}
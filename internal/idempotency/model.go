@@ -0,0 +1,73 @@
+package idempotency
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"google.golang.org/protobuf/proto"
+	"time"
+)
+
+type Record struct {
+	// ID is the key scoped to the caller that sent it, see ScopedID.
+	ID          string    `json:"id" bson:"_id,omitempty"`
+	Key         string    `json:"key" bson:"key,omitempty"`
+	Method      string    `json:"method" bson:"method,omitempty"`
+	Fingerprint string    `json:"fingerprint" bson:"fingerprint,omitempty"`
+	Response    []byte    `json:"response" bson:"response,omitempty"`
+	Done        bool      `json:"done" bson:"done"`
+	CreatedAt   time.Time `json:"created_at" bson:"created_at,omitempty"`
+	// LeasedUntil is when a record still in progress is given up: the call
+	// holding it is presumed lost with its process, and the key may be retried.
+	LeasedUntil time.Time `json:"leased_until" bson:"leased_until,omitempty"`
+}
+
+func CreateRecord(scope, key, method string, req any, lease time.Duration) (Record, error) {
+	fingerprint, err := Fingerprint(method, req)
+	if err != nil {
+		return Record{}, err
+	}
+
+	// the storages keep milliseconds, CreatedAt must match what they read back
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	return Record{
+		ID:          ScopedID(scope, key),
+		Key:         key,
+		Method:      method,
+		Fingerprint: fingerprint,
+		CreatedAt:   now,
+		LeasedUntil: now.Add(lease),
+	}, nil
+}
+
+// Leased reports whether the record is still held by the call that acquired
+// it, either done or in progress within its lease.
+func (r Record) Leased(now time.Time) bool {
+	return r.Done || now.Before(r.LeasedUntil)
+}
+
+// ScopedID identifies key among the keys of scope, so that a caller reusing
+// the key of another one does not get its response.
+func ScopedID(scope, key string) string {
+	h := sha256.New()
+	h.Write([]byte(scope))
+	h.Write([]byte{0})
+	h.Write([]byte(key))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Fingerprint identifies the payload of a request, so a key reused for
+// a different method or request body can be told apart from a retry.
+func Fingerprint(method string, req any) (string, error) {
+	h := sha256.New()
+	h.Write([]byte(method))
+
+	if m, ok := req.(proto.Message); ok {
+		data, err := proto.MarshalOptions{Deterministic: true}.Marshal(m)
+		if err != nil {
+			return "", err
+		}
+		h.Write(data)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
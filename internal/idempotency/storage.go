@@ -0,0 +1,16 @@
+package idempotency
+
+import "context"
+
+type Storage interface {
+	// Acquire stores record unless its id is already taken, in which case the
+	// existing record is returned with acquired set to false. A record in
+	// progress past its lease is replaced by record.
+	Acquire(ctx context.Context, record Record) (existing Record, acquired bool, err error)
+	// Complete stores the response of the call which acquired record, and
+	// Release gives its key up. Both only apply while the call still holds the
+	// record, told by its CreatedAt: once another call took the key over after
+	// its lease they do nothing.
+	Complete(ctx context.Context, record Record, response []byte) error
+	Release(ctx context.Context, record Record) error
+}
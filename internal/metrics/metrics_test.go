@@ -0,0 +1,37 @@
+package metrics
+
+import (
+	"context"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestUnaryServerInterceptorCountsByMethodAndCode(t *testing.T) {
+	const method = "/test.Service/Count"
+
+	interceptor := UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: method}
+	ok := func(context.Context, any) (any, error) { return "ok", nil }
+	missing := func(context.Context, any) (any, error) { return nil, status.Error(codes.NotFound, "missing") }
+
+	// the counters are global, only what the calls below add is checked
+	okCalls := testutil.ToFloat64(requests.WithLabelValues(method, codes.OK.String()))
+	notFound := testutil.ToFloat64(requests.WithLabelValues(method, codes.NotFound.String()))
+
+	for _, handler := range []grpc.UnaryHandler{ok, ok, missing} {
+		_, _ = interceptor(context.Background(), nil, info, handler)
+	}
+
+	if got := testutil.ToFloat64(requests.WithLabelValues(method, codes.OK.String())) - okCalls; got != 2 {
+		t.Fatalf("got %v OK calls, want 2", got)
+	}
+	if got := testutil.ToFloat64(requests.WithLabelValues(method, codes.NotFound.String())) - notFound; got != 1 {
+		t.Fatalf("got %v NotFound calls, want 1", got)
+	}
+	if got := testutil.CollectAndCount(duration, Namespace+"_grpc_request_duration_seconds"); got < 1 {
+		t.Fatalf("got %d latency series, want the method observed", got)
+	}
+}
@@ -0,0 +1,61 @@
+package ratelimit
+
+import (
+	"context"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"net"
+	"strings"
+)
+
+const (
+	MetadataAuthorization = "authorization"
+	MetadataForwardedFor  = "x-forwarded-for"
+)
+
+// Caller identifies who is calling, preferring the subject of a bearer JWT
+// that v verifies, then the configured metadata header and finally the peer
+// host. Unverified tokens are ignored, anyone could sign one with the subject
+// of somebody else. Calls relayed by the gateway come from loopback, their
+// caller is the client address the gateway forwarded last.
+func Caller(ctx context.Context, header string, v *Verifier) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	if values := md.Get(MetadataAuthorization); len(values) > 0 {
+		if sub := v.Subject(values[0]); len(sub) > 0 {
+			return "sub:" + sub
+		}
+	}
+
+	if len(header) > 0 {
+		if values := md.Get(header); len(values) > 0 && len(values[0]) > 0 {
+			return "id:" + values[0]
+		}
+	}
+
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		host, _, err := net.SplitHostPort(p.Addr.String())
+		if err != nil {
+			host = p.Addr.String()
+		}
+		if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+			if forwarded := forwardedFor(md); len(forwarded) > 0 {
+				host = forwarded
+			}
+		}
+		return "peer:" + host
+	}
+
+	return "unknown"
+}
+
+// forwardedFor returns the last address of the x-forwarded-for metadata, the
+// one the gateway saw the request come from; the others are up to the client.
+func forwardedFor(md metadata.MD) string {
+	values := md.Get(MetadataForwardedFor)
+	if len(values) == 0 {
+		return ""
+	}
+	addrs := strings.Split(values[len(values)-1], ",")
+	return strings.TrimSpace(addrs[len(addrs)-1])
+}
@@ -0,0 +1,64 @@
+package ratelimit
+
+import (
+	"context"
+	"github.com/golang-jwt/jwt/v4"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"net"
+	"testing"
+	"time"
+)
+
+func bearer(t *testing.T, secret string, claims jwt.RegisteredClaims) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return "Bearer " + token
+}
+
+func TestCallerKeysOnVerifiedSubjectsOnly(t *testing.T) {
+	v, err := NewVerifier("secret", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	valid := bearer(t, "secret", jwt.RegisteredClaims{Subject: "u1"})
+	forged := bearer(t, "other", jwt.RegisteredClaims{Subject: "u1"})
+	expired := bearer(t, "secret", jwt.RegisteredClaims{Subject: "u1", ExpiresAt: jwt.NewNumericDate(time.Now().Add(-time.Minute))})
+
+	tests := []struct {
+		name string
+		v    *Verifier
+		md   []string
+		want string
+	}{
+		{"verified", v, []string{MetadataAuthorization, valid, "x-caller-id", "c1"}, "sub:u1"},
+		{"forged", v, []string{MetadataAuthorization, forged, "x-caller-id", "c1"}, "id:c1"},
+		{"expired", v, []string{MetadataAuthorization, expired}, "peer:10.0.0.1"},
+		{"no verifier", nil, []string{MetadataAuthorization, valid}, "peer:10.0.0.1"},
+		{"no token", v, nil, "peer:10.0.0.1"},
+	}
+
+	addr := &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 4000}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(tt.md...))
+			ctx = peer.NewContext(ctx, &peer.Peer{Addr: addr})
+			if got := Caller(ctx, "x-caller-id", tt.v); got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewVerifierRejectsBothKeys(t *testing.T) {
+	if _, err := NewVerifier("secret", "key.pem"); err == nil {
+		t.Fatal("got no error, want one")
+	}
+	if v, err := NewVerifier("", ""); v != nil || err != nil {
+		t.Fatalf("got %v (%v), want no verifier", v, err)
+	}
+}
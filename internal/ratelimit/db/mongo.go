@@ -0,0 +1,101 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-funcards/board-service/internal/ratelimit"
+	"github.com/go-funcards/mongodb"
+	"github.com/rs/zerolog"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"math"
+	"time"
+)
+
+var _ ratelimit.Limiter = (*limiter)(nil)
+
+const (
+	timeout    = time.Second
+	collection = "rate_limits"
+)
+
+type bucket struct {
+	Tokens  float64 `bson:"tokens"`
+	Allowed bool    `bson:"allowed"`
+}
+
+type limiter struct {
+	c   *mongo.Collection
+	log zerolog.Logger
+}
+
+// NewLimiter shares buckets between replicas through a collection. Buckets
+// unused for idle are removed by a TTL index.
+func NewLimiter(ctx context.Context, db *mongo.Database, idle time.Duration, log zerolog.Logger) *limiter {
+	l := &limiter{
+		c:   db.Collection(collection),
+		log: log.With().Str("storage", "mongodb").Str("collection", collection).Logger(),
+	}
+	l.indexes(ctx, idle)
+	return l
+}
+
+func (l *limiter) indexes(ctx context.Context, idle time.Duration) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	name, err := l.c.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{"updated_at", 1}},
+		Options: options.Index().SetExpireAfterSeconds(int32(idle.Seconds())),
+	})
+	if err != nil {
+		l.log.Fatal().Err(err).Msg("index not created")
+	}
+
+	l.log.Info().Str("index.name", name).Msg("index created")
+}
+
+// Take refills and decrements the bucket in a single pipeline update using the
+// server clock, so concurrent replicas never hand out the same token twice.
+func (l *limiter) Take(ctx context.Context, key string, limit ratelimit.Limit) (time.Duration, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	burst := float64(limit.Burst)
+	elapsed := bson.M{"$divide": bson.A{
+		bson.M{"$subtract": bson.A{"$$NOW", bson.M{"$ifNull": bson.A{"$updated_at", "$$NOW"}}}},
+		1000,
+	}}
+	update := mongo.Pipeline{
+		{{"$set", bson.M{
+			"tokens": bson.M{"$min": bson.A{burst, bson.M{"$add": bson.A{
+				bson.M{"$ifNull": bson.A{"$tokens", burst}},
+				bson.M{"$multiply": bson.A{elapsed, limit.Rate}},
+			}}}},
+			"updated_at": "$$NOW",
+		}}},
+		{{"$set", bson.M{"allowed": bson.M{"$gte": bson.A{"$tokens", 1}}}}},
+		{{"$set", bson.M{"tokens": bson.M{"$cond": bson.A{
+			"$allowed",
+			bson.M{"$subtract": bson.A{"$tokens", 1}},
+			"$tokens",
+		}}}}},
+	}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+
+	var b bucket
+	err := l.c.FindOneAndUpdate(ctx, bson.M{"_id": key}, update, opts).Decode(&b)
+	if mongo.IsDuplicateKeyError(err) {
+		// a concurrent upsert created the bucket first, it exists now
+		err = l.c.FindOneAndUpdate(ctx, bson.M{"_id": key}, update, opts).Decode(&b)
+	}
+	if err != nil {
+		return 0, fmt.Errorf(mongodb.ErrMsgQuery, err)
+	}
+
+	if b.Allowed {
+		return 0, nil
+	}
+	return time.Duration(math.Ceil((1 - b.Tokens) / limit.Rate * float64(time.Second))), nil
+}
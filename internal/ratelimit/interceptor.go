@@ -0,0 +1,102 @@
+package ratelimit
+
+import (
+	"context"
+	"github.com/rs/zerolog"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"sync/atomic"
+	"time"
+)
+
+type Config struct {
+	// Header names the metadata key carrying the caller identity.
+	Header  string           `yaml:"header"`
+	Default Limit            `yaml:"default"`
+	Methods map[string]Limit `yaml:"methods"`
+}
+
+// Policy holds the Config the interceptors enforce, which may be replaced
+// while they are serving.
+type Policy struct {
+	v atomic.Value
+}
+
+func NewPolicy(cfg Config) *Policy {
+	p := new(Policy)
+	p.Store(cfg)
+	return p
+}
+
+func (p *Policy) Load() Config {
+	return p.v.Load().(Config)
+}
+
+func (p *Policy) Store(cfg Config) {
+	p.v.Store(cfg)
+}
+
+// Limit returns the limit of the full gRPC method name, falling back to Default.
+func (c Config) Limit(method string) Limit {
+	if l, ok := c.Methods[method]; ok {
+		return l
+	}
+	return c.Default
+}
+
+// UnaryServerInterceptor rejects calls exceeding the caller's limit for the
+// method with ResourceExhausted and a RetryInfo telling when to come back.
+// Limiter failures are logged and the call is let through. Callers are
+// identified by Caller with v.
+func UnaryServerInterceptor(limiter Limiter, policy *Policy, v *Verifier, log zerolog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := take(ctx, limiter, policy.Load(), v, info.FullMethod, log); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func StreamServerInterceptor(limiter Limiter, policy *Policy, v *Verifier, log zerolog.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := take(stream.Context(), limiter, policy.Load(), v, info.FullMethod, log); err != nil {
+			return err
+		}
+		return handler(srv, stream)
+	}
+}
+
+func take(ctx context.Context, limiter Limiter, cfg Config, v *Verifier, method string, log zerolog.Logger) error {
+	limit := cfg.Limit(method)
+	if limit.Unlimited() {
+		return nil
+	}
+	if limit.Burst < 1 {
+		limit.Burst = 1
+	}
+
+	caller := Caller(ctx, cfg.Header, v)
+	wait, err := limiter.Take(ctx, method+"|"+caller, limit)
+	if err != nil {
+		log.Error().Err(err).Str("method", method).Str("caller", caller).Msg("rate limiter failed")
+		return nil
+	}
+	if wait <= 0 {
+		return nil
+	}
+
+	log.Debug().Str("method", method).Str("caller", caller).Dur("retry_after", wait).Msg("rate limit exceeded")
+
+	return exhausted(method, wait)
+}
+
+func exhausted(method string, wait time.Duration) error {
+	st := status.Newf(codes.ResourceExhausted, "rate limit exceeded for %s, retry in %s", method, wait.Round(time.Millisecond))
+	if ds, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(wait)}); err == nil {
+		st = ds
+	}
+	return st.Err()
+}
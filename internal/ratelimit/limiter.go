@@ -0,0 +1,89 @@
+package ratelimit
+
+import (
+	"context"
+	"golang.org/x/time/rate"
+	"sync"
+	"time"
+)
+
+// Limit is a token bucket refilled with Rate tokens per second and holding
+// at most Burst tokens. A zero Rate disables limiting.
+type Limit struct {
+	Rate  float64 `yaml:"rate"`
+	Burst int     `yaml:"burst"`
+}
+
+func (l Limit) Unlimited() bool {
+	return l.Rate <= 0
+}
+
+type Limiter interface {
+	// Take removes a token from the bucket identified by key. When the bucket
+	// is empty nothing is taken and the time until the next token is returned.
+	Take(ctx context.Context, key string, limit Limit) (wait time.Duration, err error)
+}
+
+var _ Limiter = (*localLimiter)(nil)
+
+type bucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+type localLimiter struct {
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	idle    time.Duration
+	swept   time.Time
+}
+
+// NewLocalLimiter keeps buckets in memory, forgetting those unused for idle.
+func NewLocalLimiter(idle time.Duration) *localLimiter {
+	return &localLimiter{
+		buckets: make(map[string]*bucket),
+		idle:    idle,
+		swept:   time.Now(),
+	}
+}
+
+func (l *localLimiter) Take(_ context.Context, key string, limit Limit) (time.Duration, error) {
+	now := time.Now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.sweep(now)
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{limiter: rate.NewLimiter(rate.Limit(limit.Rate), limit.Burst)}
+		l.buckets[key] = b
+	} else if b.limiter.Limit() != rate.Limit(limit.Rate) || b.limiter.Burst() != limit.Burst {
+		b.limiter.SetLimitAt(now, rate.Limit(limit.Rate))
+		b.limiter.SetBurstAt(now, limit.Burst)
+	}
+	b.lastSeen = now
+
+	r := b.limiter.ReserveN(now, 1)
+	if !r.OK() {
+		return time.Duration(float64(time.Second) / limit.Rate), nil
+	}
+	if wait := r.DelayFrom(now); wait > 0 {
+		r.CancelAt(now)
+		return wait, nil
+	}
+	return 0, nil
+}
+
+func (l *localLimiter) sweep(now time.Time) {
+	if now.Sub(l.swept) < l.idle {
+		return
+	}
+	for key, b := range l.buckets {
+		if now.Sub(b.lastSeen) >= l.idle {
+			delete(l.buckets, key)
+		}
+	}
+	l.swept = now
+}
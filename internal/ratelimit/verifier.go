@@ -0,0 +1,68 @@
+package ratelimit
+
+import (
+	"errors"
+	"fmt"
+	"github.com/golang-jwt/jwt/v4"
+	"os"
+	"strings"
+)
+
+// Verifier checks the signature and expiry of bearer JWTs, so that only the
+// subjects of tokens it verifies identify callers. A nil Verifier verifies
+// no token.
+type Verifier struct {
+	key     any
+	methods []string
+}
+
+// NewVerifier verifies HMAC signed tokens with secret or, with publicKey the
+// path of a PEM file, RSA, ECDSA and Ed25519 signed ones. It returns nil when
+// neither is set.
+func NewVerifier(secret, publicKey string) (*Verifier, error) {
+	switch {
+	case len(secret) > 0 && len(publicKey) > 0:
+		return nil, errors.New("set either a JWT secret or a JWT public key, not both")
+	case len(secret) > 0:
+		return &Verifier{key: []byte(secret), methods: []string{"HS256", "HS384", "HS512"}}, nil
+	case len(publicKey) == 0:
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(publicKey)
+	if err != nil {
+		return nil, err
+	}
+	if key, err := jwt.ParseRSAPublicKeyFromPEM(data); err == nil {
+		return &Verifier{key: key, methods: []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512"}}, nil
+	}
+	if key, err := jwt.ParseECPublicKeyFromPEM(data); err == nil {
+		return &Verifier{key: key, methods: []string{"ES256", "ES384", "ES512"}}, nil
+	}
+	if key, err := jwt.ParseEdPublicKeyFromPEM(data); err == nil {
+		return &Verifier{key: key, methods: []string{"EdDSA"}}, nil
+	}
+	return nil, fmt.Errorf("%s: not an RSA, ECDSA or Ed25519 public key", publicKey)
+}
+
+// Subject returns the subject of the bearer JWT in authorization, or an empty
+// string when the token is missing, expired or does not verify.
+func (v *Verifier) Subject(authorization string) string {
+	if v == nil {
+		return ""
+	}
+
+	token := strings.TrimSpace(authorization)
+	if len(token) < 7 || !strings.EqualFold(token[:7], "bearer ") {
+		return ""
+	}
+
+	claims := jwt.RegisteredClaims{}
+	_, err := jwt.NewParser(jwt.WithValidMethods(v.methods)).ParseWithClaims(strings.TrimSpace(token[7:]), &claims, func(*jwt.Token) (any, error) {
+		return v.key, nil
+	})
+	if err != nil {
+		return ""
+	}
+	return claims.Subject
+}
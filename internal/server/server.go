@@ -0,0 +1,54 @@
+package server
+
+import (
+	"context"
+	"github.com/rs/zerolog"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+	"net"
+	"time"
+)
+
+// Serve runs srv on lis until ctx is done. It then calls each of onStop, keeps
+// serving for drain so load balancers notice the service is going away, and
+// stops srv gracefully: no new RPCs are accepted and the in-flight ones, streams
+// included, may finish. They are cancelled when they outlast timeout.
+func Serve(ctx context.Context, lis net.Listener, srv *grpc.Server, drain, timeout time.Duration, log zerolog.Logger, onStop ...func()) error {
+	g, gCtx := errgroup.WithContext(ctx)
+
+	g.Go(func() error {
+		return srv.Serve(lis)
+	})
+
+	g.Go(func() error {
+		<-gCtx.Done()
+
+		log.Info().Msg("stopping grpc server...")
+
+		for _, fn := range onStop {
+			fn()
+		}
+
+		if drain > 0 {
+			log.Info().Dur("drain", drain).Msg("draining grpc server...")
+			time.Sleep(drain)
+		}
+
+		stopped := make(chan struct{})
+		go func() {
+			srv.GracefulStop()
+			close(stopped)
+		}()
+
+		select {
+		case <-stopped:
+		case <-time.After(timeout):
+			log.Error().Msg("shutdown grace period elapsed, force stop")
+			srv.Stop()
+		}
+
+		return nil
+	})
+
+	return g.Wait()
+}
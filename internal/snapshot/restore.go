@@ -0,0 +1,329 @@
+package snapshot
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/go-funcards/board-service/internal/board"
+	"io"
+	"os"
+	"strings"
+)
+
+// ErrNotEmpty is returned when restoring into a database that has boards,
+// workspaces or audit entries already.
+var ErrNotEmpty = errors.New("database is not empty")
+
+// Verify checks the archive at path against its checksum file, when there is
+// one, and every entry against the manifest, decoding each line. It reports
+// whether the checksum file was found.
+func Verify(path string) (Manifest, bool, error) {
+	checked, err := verifyChecksum(path)
+	if err != nil {
+		return Manifest{}, false, err
+	}
+
+	var m Manifest
+	seen := make(map[string]bool)
+	err = entries(path, func(name string, r io.Reader) error {
+		if name == ManifestFile {
+			if err := json.NewDecoder(r).Decode(&m); err != nil {
+				return fmt.Errorf("%s: %w", ManifestFile, err)
+			}
+			return nil
+		}
+		if m.Format == "" {
+			return fmt.Errorf("%s is not the first entry", ManifestFile)
+		}
+
+		file, ok := m.file(name)
+		if !ok {
+			return fmt.Errorf("entry %s is not in the manifest", name)
+		}
+		seen[name] = true
+
+		h := sha256.New()
+		cr := &countingReader{r: io.TeeReader(r, h)}
+		n, err := decode(name, cr, func(line []byte) error { return nil })
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case cr.n != file.Size:
+			return fmt.Errorf("entry %s has %d bytes, the manifest says %d", name, cr.n, file.Size)
+		case hex.EncodeToString(h.Sum(nil)) != file.SHA256:
+			return fmt.Errorf("entry %s does not match its checksum", name)
+		case n != file.Entries:
+			return fmt.Errorf("entry %s has %d entries, the manifest says %d", name, n, file.Entries)
+		}
+		return nil
+	})
+	if err != nil {
+		return Manifest{}, checked, err
+	}
+
+	if m.Format != Format {
+		return Manifest{}, checked, fmt.Errorf("format %q is not %q", m.Format, Format)
+	}
+	if m.Version < 1 || m.Version > Version {
+		return Manifest{}, checked, fmt.Errorf("version %d is not supported, at most %d is", m.Version, Version)
+	}
+	for _, file := range m.Files {
+		if !seen[file.Name] {
+			return Manifest{}, checked, fmt.Errorf("entry %s is missing", file.Name)
+		}
+	}
+
+	return m, checked, nil
+}
+
+// Restore verifies the archive at path and loads it into s, which must be
+// empty. Boards keep their version, versions and audit entries are appended
+// as they were, the audit entries getting new ids in the same order. When an
+// entry fails to load, what was loaded is deleted again, leaving s empty for
+// another attempt.
+func Restore(ctx context.Context, s Storages, path string) (Manifest, error) {
+	m, _, err := Verify(path)
+	if err != nil {
+		return Manifest{}, err
+	}
+
+	n, err := s.Boards.Count(ctx, board.Filter{})
+	if err != nil {
+		return Manifest{}, err
+	}
+	workspaces, err := s.Workspaces.Count(ctx, board.WorkspaceFilter{})
+	if err != nil {
+		return Manifest{}, err
+	}
+	audit, err := newestAudit(ctx, s.Audit)
+	if err != nil {
+		return Manifest{}, err
+	}
+	if n > 0 || workspaces > 0 || audit != "" {
+		return Manifest{}, fmt.Errorf("%w: %d boards, %d workspaces, audit log not empty: %t", ErrNotEmpty, n, workspaces, audit != "")
+	}
+
+	loaded := restored{history: make(map[string]bool)}
+	err = entries(path, func(name string, r io.Reader) error {
+		switch name {
+		case BoardsFile:
+			return load(name, r, func(batch []board.Board) error {
+				errs, err := s.Boards.Restore(ctx, batch)
+				if err != nil {
+					return err
+				}
+				var failed error
+				for i, err := range errs {
+					if err == nil {
+						loaded.boards = append(loaded.boards, batch[i].BoardID)
+					} else if failed == nil {
+						failed = fmt.Errorf("board %s not restored: %w", batch[i].BoardID, err)
+					}
+				}
+				return failed
+			})
+		case WorkspacesFile:
+			return load(name, r, func(batch []board.Workspace) error {
+				for _, item := range batch {
+					if err := s.Workspaces.Create(ctx, item); err != nil {
+						return fmt.Errorf("workspace %s not restored: %w", item.WorkspaceID, err)
+					}
+					loaded.workspaces = append(loaded.workspaces, item.WorkspaceID)
+				}
+				return nil
+			})
+		case VersionsFile:
+			return load(name, r, func(batch []board.BoardVersion) error {
+				// a failed append may have stored part of the batch
+				for _, v := range batch {
+					loaded.history[v.BoardID] = true
+				}
+				return s.Versions.Append(ctx, batch...)
+			})
+		case AuditFile:
+			return load(name, r, func(batch []board.AuditEntry) error {
+				for _, entry := range batch {
+					loaded.history[entry.BoardID] = true
+				}
+				return s.Audit.Append(ctx, batch...)
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		// the restore may have failed because ctx is done, undoing must not
+		if uerr := loaded.undo(context.Background(), s); uerr != nil {
+			return Manifest{}, fmt.Errorf("%w; undoing the partial restore failed, the database is not empty: %v", err, uerr)
+		}
+		return Manifest{}, err
+	}
+
+	return m, nil
+}
+
+// restored records what Restore loaded, to undo it.
+type restored struct {
+	boards     []string
+	workspaces []string
+	// history holds the boards versions or audit entries were appended for.
+	history map[string]bool
+}
+
+// undo deletes what was loaded, stopping at the first error.
+func (r restored) undo(ctx context.Context, s Storages) error {
+	if len(r.boards) > 0 {
+		errs, err := s.Boards.DeleteMany(ctx, r.boards)
+		if err != nil {
+			return err
+		}
+		for i, err := range errs {
+			if err != nil {
+				return fmt.Errorf("board %s not deleted: %w", r.boards[i], err)
+			}
+		}
+	}
+	for _, id := range r.workspaces {
+		if err := s.Workspaces.Delete(ctx, id); err != nil {
+			return fmt.Errorf("workspace %s not deleted: %w", id, err)
+		}
+	}
+
+	ids := make([]string, 0, len(r.history))
+	for id := range r.history {
+		ids = append(ids, id)
+	}
+	if err := s.Versions.Purge(ctx, ids...); err != nil {
+		return err
+	}
+	return s.Audit.Purge(ctx, ids...)
+}
+
+// load decodes the lines of an entry into batches of T.
+func load[T any](name string, r io.Reader, fn func(batch []T) error) error {
+	batch := make([]T, 0, pageSize)
+	_, err := decode(name, r, func(line []byte) error {
+		var item T
+		if err := json.Unmarshal(line, &item); err != nil {
+			return err
+		}
+		if batch = append(batch, item); len(batch) < pageSize {
+			return nil
+		}
+		err := fn(batch)
+		batch = batch[:0]
+		return err
+	})
+	if err != nil || len(batch) == 0 {
+		return err
+	}
+	return fn(batch)
+}
+
+// decode passes every line of an entry to fn, after checking the export
+// header of the boards, and returns the number of lines passed.
+func decode(name string, r io.Reader, fn func(line []byte) error) (uint64, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, maxLine)
+
+	var n uint64
+	for line := 1; scanner.Scan(); line++ {
+		if line == 1 && name == BoardsFile {
+			var header board.ExportHeader
+			if err := json.Unmarshal(scanner.Bytes(), &header); err != nil {
+				return n, fmt.Errorf("%s line 1: %w", name, err)
+			}
+			if header.Format != board.ExportFormat || header.Version < 1 || header.Version > board.ExportVersion {
+				return n, fmt.Errorf("%s line 1: %s version %d is not supported", name, header.Format, header.Version)
+			}
+			continue
+		}
+		if !json.Valid(scanner.Bytes()) {
+			return n, fmt.Errorf("%s line %d: invalid JSON", name, line)
+		}
+		if err := fn(scanner.Bytes()); err != nil {
+			return n, fmt.Errorf("%s line %d: %w", name, line, err)
+		}
+		n++
+	}
+	return n, scanner.Err()
+}
+
+// entries passes every entry of the archive at path to fn, in order.
+func entries(path string, fn func(name string, r io.Reader) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return err
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err = fn(header.Name, tr); err != nil {
+			return err
+		}
+	}
+}
+
+// verifyChecksum compares the archive with the checksum written next to it,
+// reporting whether there was one.
+func verifyChecksum(path string) (bool, error) {
+	data, err := os.ReadFile(path + ".sha256")
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	fields := strings.Fields(string(data))
+	if len(fields) == 0 {
+		return false, fmt.Errorf("%s.sha256 is empty", path)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err = io.Copy(h, f); err != nil {
+		return false, err
+	}
+	if hex.EncodeToString(h.Sum(nil)) != strings.ToLower(fields[0]) {
+		return false, fmt.Errorf("%s does not match its checksum", path)
+	}
+	return true, nil
+}
+
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,100 @@
+package snapshot
+
+import (
+	"context"
+	"errors"
+	"github.com/go-funcards/board-service/internal/board"
+	boltdb "github.com/go-funcards/board-service/internal/board/db/bolt"
+	"github.com/rs/zerolog"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// failingAudit stores the first entry of an append and fails the rest, like
+// a write interrupted halfway.
+type failingAudit struct {
+	board.AuditStorage
+}
+
+func (s failingAudit) Append(ctx context.Context, entries ...board.AuditEntry) error {
+	if len(entries) > 0 {
+		if err := s.AuditStorage.Append(ctx, entries[0]); err != nil {
+			return err
+		}
+	}
+	return errors.New("connection reset")
+}
+
+func open(t *testing.T) Storages {
+	log := zerolog.Nop()
+	db := boltdb.Open(filepath.Join(t.TempDir(), "boards.db"), log)
+	t.Cleanup(func() { _ = db.Close() })
+
+	return Storages{
+		Boards:     boltdb.NewStorage(db, boltdb.NewQuotaStorage(db, board.Limits{}, log), log),
+		Workspaces: boltdb.NewWorkspaceStorage(db, log),
+		Audit:      boltdb.NewAuditStorage(db, log),
+		Versions:   boltdb.NewVersionStorage(db, 0, 0, log),
+	}
+}
+
+func TestRestoreUndoesWhatItLoadedWhenItFailsPartway(t *testing.T) {
+	ctx := context.Background()
+	log := zerolog.Nop()
+
+	source := open(t)
+	if err := source.Workspaces.Create(ctx, board.Workspace{WorkspaceID: "w1", OwnerID: "o1", CreatedAt: time.Now().UTC()}); err != nil {
+		t.Fatal(err)
+	}
+	boards := board.NewVersionStorage(board.NewAuditStorage(source.Boards, source.Audit, log), source.Versions, log)
+	for _, b := range []board.Board{
+		{BoardID: "b1", WorkspaceID: "w1", OwnerID: "o1", Name: "first", CreatedAt: time.Now().UTC()},
+		{BoardID: "b2", WorkspaceID: "w1", OwnerID: "o1", Name: "second", CreatedAt: time.Now().UTC()},
+		{BoardID: "b1", Name: "renamed"},
+	} {
+		if err := boards.Save(ctx, b); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	path := filepath.Join(t.TempDir(), "boards.tar.gz")
+	if _, err := Write(ctx, source, "bolt", path, log); err != nil {
+		t.Fatal(err)
+	}
+
+	// the audit log is loaded last, after the boards, workspaces and versions
+	target := open(t)
+	failing := target
+	failing.Audit = failingAudit{target.Audit}
+	if _, err := Restore(ctx, failing, path); err == nil {
+		t.Fatal("got no error, want the restore to fail")
+	}
+
+	if n, err := target.Boards.Count(ctx, board.Filter{}); err != nil || n != 0 {
+		t.Fatalf("got %d boards (%v), want none left", n, err)
+	}
+	if n, err := target.Workspaces.Count(ctx, board.WorkspaceFilter{}); err != nil || n != 0 {
+		t.Fatalf("got %d workspaces (%v), want none left", n, err)
+	}
+	if n, err := target.Versions.Count(ctx, "b1"); err != nil || n != 0 {
+		t.Fatalf("got %d versions of b1 (%v), want none left", n, err)
+	}
+	if id, err := newestAudit(ctx, target.Audit); err != nil || id != "" {
+		t.Fatalf("got audit entry %q (%v), want none left", id, err)
+	}
+
+	// the database is empty again, so the restore can be retried
+	m, err := Restore(ctx, target, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range m.Files {
+		if f.Entries == 0 {
+			t.Fatalf("got no %s restored", f.Name)
+		}
+	}
+	if n, err := target.Versions.Count(ctx, "b1"); err != nil || n != 2 {
+		t.Fatalf("got %d versions of b1 (%v), want 2", n, err)
+	}
+}
@@ -0,0 +1,462 @@
+// Package snapshot writes and reloads logical backups of the board storage:
+// a gzip compressed tar holding a manifest, the boards, their workspaces,
+// versions and the audit log as JSON Lines.
+package snapshot
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/go-funcards/board-service/internal/board"
+	"github.com/rs/zerolog"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// Version 2 added the workspaces, version 1 snapshots are restored without.
+const (
+	Format  = "board-service/backup"
+	Version = 2
+)
+
+const (
+	ManifestFile   = "manifest.json"
+	BoardsFile     = "boards.jsonl"
+	WorkspacesFile = "workspaces.jsonl"
+	VersionsFile   = "versions.jsonl"
+	AuditFile      = "audit.jsonl"
+)
+
+const (
+	pageSize    = 500
+	maxAttempts = 3
+	maxLine     = 16 << 20
+)
+
+// ErrChanged is returned when boards kept changing while the snapshot was
+// read, on every attempt.
+var ErrChanged = errors.New("boards changed while the snapshot was read")
+
+// Storages are the storages a snapshot is read from and restored into. Boards
+// is the storage of the driver itself, not the audited and versioned one, so
+// that restoring does not record the restore.
+type Storages struct {
+	Boards     board.Storage
+	Workspaces board.WorkspaceStorage
+	Audit      board.AuditStorage
+	Versions   board.VersionStorage
+}
+
+type File struct {
+	Name    string `json:"name"`
+	Entries uint64 `json:"entries"`
+	Size    int64  `json:"size"`
+	SHA256  string `json:"sha256"`
+}
+
+// Manifest describes a snapshot. It is the first entry of the archive and
+// holds the checksum of every other entry.
+type Manifest struct {
+	Format    string    `json:"format"`
+	Version   int       `json:"version"`
+	CreatedAt time.Time `json:"created_at"`
+	Driver    string    `json:"driver"`
+	// AuditMark is the newest audit entry when the snapshot was read.
+	AuditMark string `json:"audit_mark,omitempty"`
+	Files     []File `json:"files"`
+}
+
+func (m Manifest) file(name string) (File, bool) {
+	for _, f := range m.Files {
+		if f.Name == name {
+			return f, true
+		}
+	}
+	return File{}, false
+}
+
+// Write reads a snapshot of s into a new archive at path, and its checksum
+// into path.sha256.
+//
+// The snapshot is best effort: it is read page by page, not from a snapshot
+// of the database, which not every driver offers. Writes of the service to
+// boards append to the audit log after the board is written, so when the
+// newest audit entry changed while the snapshot was read it is read again, up
+// to maxAttempts times, but a write whose entry is appended after the check
+// goes unnoticed, and changes to workspaces are not audited at all. Back up a
+// service that takes no writes for an exact copy.
+func Write(ctx context.Context, s Storages, driver, path string, log zerolog.Logger) (Manifest, error) {
+	dir, err := os.MkdirTemp(filepath.Dir(path), ".snapshot-")
+	if err != nil {
+		return Manifest{}, err
+	}
+	defer os.RemoveAll(dir)
+
+	var m Manifest
+	for attempt := 1; ; attempt++ {
+		if m, err = read(ctx, s, dir); err == nil {
+			break
+		}
+		if !errors.Is(err, ErrChanged) || attempt == maxAttempts {
+			return Manifest{}, err
+		}
+		log.Warn().Int("attempt", attempt).Msg("boards changed while the snapshot was read, reading again")
+	}
+
+	m.Format = Format
+	m.Version = Version
+	m.CreatedAt = time.Now().UTC()
+	m.Driver = driver
+
+	for i := range m.Files {
+		if m.Files[i].SHA256, m.Files[i].Size, err = checksum(filepath.Join(dir, m.Files[i].Name)); err != nil {
+			return Manifest{}, err
+		}
+	}
+
+	sum, err := archive(dir, m, path)
+	if err != nil {
+		return Manifest{}, err
+	}
+
+	line := fmt.Sprintf("%s  %s\n", sum, filepath.Base(path))
+	if err = os.WriteFile(path+".sha256", []byte(line), 0o644); err != nil {
+		return Manifest{}, err
+	}
+
+	return m, nil
+}
+
+// read writes the boards, workspaces, versions and audit log of s to files in dir.
+func read(ctx context.Context, s Storages, dir string) (Manifest, error) {
+	mark, err := newestAudit(ctx, s.Audit)
+	if err != nil {
+		return Manifest{}, err
+	}
+
+	// versions are kept for deleted boards too, the audit log names those
+	ids := make(map[string]bool)
+
+	audit, err := readAudit(ctx, s.Audit, dir, ids)
+	if err != nil {
+		return Manifest{}, err
+	}
+
+	boards, err := create(dir, BoardsFile)
+	if err != nil {
+		return Manifest{}, err
+	}
+	defer boards.close()
+
+	// the boards file is an export, it can be loaded by ImportBoards as well
+	err = boards.enc.Encode(board.ExportHeader{
+		Format:     board.ExportFormat,
+		Version:    board.ExportVersion,
+		ExportedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return Manifest{}, err
+	}
+	for index := uint64(0); ; index++ {
+		data, err := s.Boards.Find(ctx, board.Filter{}, index, pageSize)
+		if err != nil {
+			return Manifest{}, err
+		}
+		for _, item := range data {
+			ids[item.BoardID] = true
+			if err = boards.encode(item); err != nil {
+				return Manifest{}, err
+			}
+		}
+		if len(data) < pageSize {
+			break
+		}
+	}
+	if err = boards.close(); err != nil {
+		return Manifest{}, err
+	}
+
+	workspaces, err := readWorkspaces(ctx, s.Workspaces, dir)
+	if err != nil {
+		return Manifest{}, err
+	}
+
+	versions, err := readVersions(ctx, s.Versions, dir, ids)
+	if err != nil {
+		return Manifest{}, err
+	}
+
+	after, err := newestAudit(ctx, s.Audit)
+	if err != nil {
+		return Manifest{}, err
+	}
+	if after != mark {
+		return Manifest{}, ErrChanged
+	}
+
+	return Manifest{
+		AuditMark: mark,
+		Files: []File{
+			{Name: BoardsFile, Entries: boards.entries},
+			{Name: WorkspacesFile, Entries: workspaces},
+			{Name: VersionsFile, Entries: versions},
+			{Name: AuditFile, Entries: audit},
+		},
+	}, nil
+}
+
+func newestAudit(ctx context.Context, audit board.AuditStorage) (string, error) {
+	data, err := audit.Find(ctx, board.AuditFilter{}, 1)
+	if err != nil || len(data) == 0 {
+		return "", err
+	}
+	return data[0].AuditID, nil
+}
+
+// readAudit writes the audit log oldest first, the order it is appended in
+// again on restore. It is read newest first, so the entries are spooled and
+// copied back in reverse.
+func readAudit(ctx context.Context, audit board.AuditStorage, dir string, ids map[string]bool) (uint64, error) {
+	spool, err := os.Create(filepath.Join(dir, AuditFile+".spool"))
+	if err != nil {
+		return 0, err
+	}
+	defer spool.Close()
+
+	w := bufio.NewWriter(spool)
+	var offsets []int64
+	var offset int64
+	for filter := (board.AuditFilter{}); ; {
+		data, err := audit.Find(ctx, filter, pageSize)
+		if err != nil {
+			return 0, err
+		}
+		for _, item := range data {
+			if len(item.BoardID) > 0 {
+				ids[item.BoardID] = true
+			}
+			line, err := json.Marshal(item)
+			if err != nil {
+				return 0, err
+			}
+			offsets = append(offsets, offset)
+			n, _ := w.Write(append(line, '\n'))
+			offset += int64(n)
+		}
+		if len(data) < pageSize {
+			break
+		}
+		filter.AfterID = data[len(data)-1].AuditID
+	}
+	if err = w.Flush(); err != nil {
+		return 0, err
+	}
+
+	out, err := create(dir, AuditFile)
+	if err != nil {
+		return 0, err
+	}
+	defer out.close()
+
+	end := offset
+	for i := len(offsets) - 1; i >= 0; i-- {
+		line := make([]byte, end-offsets[i])
+		if _, err = spool.ReadAt(line, offsets[i]); err != nil {
+			return 0, err
+		}
+		if _, err = out.w.Write(line); err != nil {
+			return 0, err
+		}
+		out.entries++
+		end = offsets[i]
+	}
+
+	return out.entries, out.close()
+}
+
+func readWorkspaces(ctx context.Context, workspaces board.WorkspaceStorage, dir string) (uint64, error) {
+	out, err := create(dir, WorkspacesFile)
+	if err != nil {
+		return 0, err
+	}
+	defer out.close()
+
+	for index := uint64(0); ; index++ {
+		data, err := workspaces.Find(ctx, board.WorkspaceFilter{}, index, pageSize)
+		if err != nil {
+			return 0, err
+		}
+		for _, item := range data {
+			if err = out.encode(item); err != nil {
+				return 0, err
+			}
+		}
+		if len(data) < pageSize {
+			break
+		}
+	}
+
+	return out.entries, out.close()
+}
+
+func readVersions(ctx context.Context, versions board.VersionStorage, dir string, ids map[string]bool) (uint64, error) {
+	out, err := create(dir, VersionsFile)
+	if err != nil {
+		return 0, err
+	}
+	defer out.close()
+
+	sorted := make([]string, 0, len(ids))
+	for id := range ids {
+		sorted = append(sorted, id)
+	}
+	sort.Strings(sorted)
+
+	for _, id := range sorted {
+		for index := uint64(0); ; index++ {
+			data, err := versions.Find(ctx, id, index, pageSize)
+			if err != nil {
+				return 0, err
+			}
+			for _, item := range data {
+				if err = out.encode(item); err != nil {
+					return 0, err
+				}
+			}
+			if len(data) < pageSize {
+				break
+			}
+		}
+	}
+
+	return out.entries, out.close()
+}
+
+// archive writes the manifest and the files of dir to a gzip compressed tar
+// at path, returning its checksum. The archive only replaces path once it is
+// complete.
+func archive(dir string, m Manifest, path string) (string, error) {
+	tmp := path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(tmp)
+	defer f.Close()
+
+	h := sha256.New()
+	gz := gzip.NewWriter(io.MultiWriter(f, h))
+	tw := tar.NewWriter(gz)
+
+	manifest, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	if err = writeEntry(tw, ManifestFile, int64(len(manifest)), m.CreatedAt, bytes.NewReader(manifest)); err != nil {
+		return "", err
+	}
+
+	for _, file := range m.Files {
+		in, err := os.Open(filepath.Join(dir, file.Name))
+		if err != nil {
+			return "", err
+		}
+		err = writeEntry(tw, file.Name, file.Size, m.CreatedAt, in)
+		in.Close()
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if err = tw.Close(); err != nil {
+		return "", err
+	}
+	if err = gz.Close(); err != nil {
+		return "", err
+	}
+	if err = f.Sync(); err != nil {
+		return "", err
+	}
+	if err = f.Close(); err != nil {
+		return "", err
+	}
+	if err = os.Rename(tmp, path); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func writeEntry(tw *tar.Writer, name string, size int64, modTime time.Time, r io.Reader) error {
+	err := tw.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    0o644,
+		Size:    size,
+		ModTime: modTime,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(tw, r)
+	return err
+}
+
+func checksum(path string) (string, int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", 0, err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	n, err := io.Copy(h, f)
+	if err != nil {
+		return "", 0, err
+	}
+	return hex.EncodeToString(h.Sum(nil)), n, nil
+}
+
+// jsonlFile is a JSON Lines file being written.
+type jsonlFile struct {
+	f       *os.File
+	w       *bufio.Writer
+	enc     *json.Encoder
+	entries uint64
+	closed  bool
+}
+
+func create(dir, name string) (*jsonlFile, error) {
+	f, err := os.Create(filepath.Join(dir, name))
+	if err != nil {
+		return nil, err
+	}
+	w := bufio.NewWriter(f)
+	return &jsonlFile{f: f, w: w, enc: json.NewEncoder(w)}, nil
+}
+
+func (f *jsonlFile) encode(v any) error {
+	f.entries++
+	return f.enc.Encode(v)
+}
+
+func (f *jsonlFile) close() error {
+	if f.closed {
+		return nil
+	}
+	f.closed = true
+	if err := f.w.Flush(); err != nil {
+		f.f.Close()
+		return err
+	}
+	return f.f.Close()
+}
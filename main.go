@@ -6,24 +6,65 @@ import (
 	"fmt"
 	"github.com/go-funcards/board-service/internal/board"
 	"github.com/go-funcards/board-service/internal/board/db"
+	boltdb "github.com/go-funcards/board-service/internal/board/db/bolt"
+	"github.com/go-funcards/board-service/internal/board/db/postgres"
+	"github.com/go-funcards/board-service/internal/cache"
 	"github.com/go-funcards/board-service/internal/config"
+	"github.com/go-funcards/board-service/internal/gateway"
+	"github.com/go-funcards/board-service/internal/healthcheck"
+	"github.com/go-funcards/board-service/internal/idempotency"
+	idempotencydb "github.com/go-funcards/board-service/internal/idempotency/db"
+	"github.com/go-funcards/board-service/internal/metrics"
+	"github.com/go-funcards/board-service/internal/ratelimit"
+	ratelimitdb "github.com/go-funcards/board-service/internal/ratelimit/db"
+	srv "github.com/go-funcards/board-service/internal/server"
+	"github.com/go-funcards/board-service/internal/snapshot"
+	"github.com/go-funcards/board-service/internal/tracing"
 	"github.com/go-funcards/board-service/proto/v1"
-	"github.com/go-funcards/grpc-server"
 	"github.com/go-funcards/grpc-server/grpc_middleware/recovery"
 	"github.com/go-funcards/mongodb"
-	"github.com/go-funcards/validate"
 	"github.com/jwreagor/grpc-zerolog"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/redis/go-redis/v9"
 	"github.com/rs/zerolog"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 	"io"
 	"net"
 	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
 	"time"
 )
 
 //go:generate sh genproto.sh
 
+const (
+	driverMongoDB  = "mongodb"
+	driverPostgres = "postgres"
+	driverBolt     = "bolt"
+)
+
+const (
+	cmdMigrate = "migrate"
+	cmdIndexes = "indexes"
+	cmdBackup  = "backup"
+	cmdRestore = "restore"
+)
+
+const (
+	cacheMemory = "memory"
+	cacheRedis  = "redis"
+)
+
 const (
 	envConfigFile = "CONFIG_FILE"
 	envLogLevel   = "LOG_LEVEL"
@@ -63,17 +104,18 @@ func init() {
 		logOutput = zerolog.ConsoleWriter{Out: os.Stdout}
 	}
 
+	zerolog.SetGlobalLevel(logLevel)
 	zerolog.TimeFieldFormat = time.RFC3339Nano
 	zerolog.TimestampFieldName = "timestamp"
 	zerolog.LevelFieldName = "severity"
 }
 
 func main() {
-	ctx := context.Background()
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, os.Interrupt)
+	defer stop()
 
 	log := zerolog.
 		New(logOutput).
-		Level(logLevel).
 		With().
 		Caller().
 		Timestamp().
@@ -87,20 +129,270 @@ func main() {
 
 	cfg := config.GetConfig(configFile, log)
 
-	validate.Default.RegisterStructRules(cfg.Validation.Rules, []any{
-		v1.CreateBoardRequest_Member{},
-		v1.CreateBoardRequest{},
-		v1.UpdateBoardRequest_Member{},
-		v1.UpdateBoardRequest{},
-		v1.DeleteBoardRequest{},
-		v1.BoardsRequest{},
-	}...)
+	if len(cfg.Log.Level) > 0 {
+		level, err := zerolog.ParseLevel(cfg.Log.Level)
+		if err != nil {
+			log.Fatal().Err(err).Msg("invalid log level")
+		}
+		zerolog.SetGlobalLevel(level)
+	}
+
+	validator, err := board.NewValidator(cfg.Validation.Rules)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to register validation rules")
+	}
+	validatorRef := board.NewValidatorRef(validator)
+
+	tracerProvider, err := tracing.NewTracerProvider(ctx, tracing.Config{
+		Exporter:    cfg.Tracing.Exporter,
+		Endpoint:    cfg.Tracing.Endpoint,
+		Insecure:    cfg.Tracing.Insecure,
+		SampleRatio: cfg.Tracing.SampleRatio,
+	}, "board-service", version)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create tracer provider")
+	}
+
+	// only the mongodb driver needs MongoDB, the others may use it for
+	// distributed rate limits
+	var mongoDB *mongo.Database
+	if len(cfg.MongoDB.URI) > 0 {
+		mongoDB = mongodb.GetDB(ctx, cfg.MongoDB.URI, log)
+	} else if cfg.Storage.Driver == driverMongoDB {
+		log.Fatal().Msgf("mongodb uri is required by storage driver %q", cfg.Storage.Driver)
+	}
+
+	opts := db.Options{
+		Timeout:  cfg.Storage.Timeout,
+		Timeouts: cfg.Storage.Timeouts,
+		Retry: db.RetryPolicy{
+			MaxAttempts:    cfg.Storage.Retry.MaxAttempts,
+			InitialBackoff: cfg.Storage.Retry.InitialBackoff,
+			MaxBackoff:     cfg.Storage.Retry.MaxBackoff,
+		},
+	}
+
+	var migrator *db.Migrator
+	var reconciler *db.IndexReconciler
+	if cfg.Storage.Driver == driverMongoDB {
+		migrator = db.NewMigrator(mongoDB, opts, log)
+		reconciler = db.NewIndexReconciler(mongoDB,
+			db.Indexes(cfg.History.MaxAge, cfg.Idempotency.TTL),
+			cfg.Storage.Indexes.DropUnexpected,
+			log,
+		)
+	}
+
+	if cmd := flag.Arg(0); cmd == cmdMigrate || cmd == cmdIndexes {
+		if migrator == nil {
+			log.Fatal().Msgf("%s applies to storage driver %q", cmd, driverMongoDB)
+		}
+		if cmd == cmdMigrate {
+			err = migrate(ctx, migrator, flag.Arg(1), os.Stdout)
+		} else {
+			err = indexes(ctx, migrator, reconciler, flag.Arg(1), os.Stdout)
+		}
+		if err != nil {
+			log.Fatal().Err(err).Msgf("%s failed", cmd)
+		}
+		if err = mongoDB.Client().Disconnect(context.Background()); err != nil {
+			log.Error().Err(err).Msg("failed to disconnect mongodb")
+		}
+		return
+	}
+
+	var boardStorage board.Storage
+	var workspaceStorage board.WorkspaceStorage
+	var quotaStorage board.QuotaStorage
+	var auditStorage board.AuditStorage
+	var versionStorage board.VersionStorage
+	var idempotencyStorage idempotency.Storage
+	var onClose []func()
+	pings := map[string]healthcheck.Ping{}
+
+	if mongoDB != nil {
+		pings[driverMongoDB] = func(ctx context.Context) error {
+			return mongoDB.Client().Ping(ctx, readpref.Primary())
+		}
+	}
+
+	switch cfg.Storage.Driver {
+	case driverMongoDB:
+		// one replica at a time migrates and reconciles the indexes
+		err = migrator.Locked(ctx, func(ctx context.Context) error {
+			if cfg.Storage.AutoMigrate {
+				if _, err := migrator.Apply(ctx); err != nil {
+					return fmt.Errorf("migration failed: %w", err)
+				}
+			}
+			if _, err := reconciler.Apply(ctx); err != nil {
+				return fmt.Errorf("indexes not reconciled: %w", err)
+			}
+			return nil
+		})
+		if err != nil {
+			log.Fatal().Err(err).Msg("schema not updated")
+		}
+
+		quotas := db.NewQuotaStorage(mongoDB, cfg.Quotas.Default, opts, log)
+		boardStorage = db.NewStorage(mongoDB, quotas, opts, log)
+		workspaceStorage = db.NewWorkspaceStorage(mongoDB, opts, log)
+		quotaStorage = quotas
+		auditStorage = db.NewAuditStorage(mongoDB, opts, log)
+		versionStorage = db.NewVersionStorage(mongoDB, cfg.History.MaxVersions, opts, log)
+		idempotencyStorage = idempotencydb.NewStorage(mongoDB, cfg.Storage.Timeout, log)
+	case driverBolt:
+		boltDB := boltdb.Open(cfg.Bolt.Path, log)
+		quotas := boltdb.NewQuotaStorage(boltDB, cfg.Quotas.Default, log)
+		boardStorage = boltdb.NewStorage(boltDB, quotas, log)
+		workspaceStorage = boltdb.NewWorkspaceStorage(boltDB, log)
+		quotaStorage = quotas
+		auditStorage = boltdb.NewAuditStorage(boltDB, log)
+		versionStorage = boltdb.NewVersionStorage(boltDB, cfg.History.MaxVersions, cfg.History.MaxAge, log)
+		idempotencyStorage = idempotencydb.NewBoltStorage(ctx, boltDB, cfg.Idempotency.TTL, log)
+
+		onClose = append(onClose, func() {
+			if err := boltDB.Close(); err != nil {
+				log.Error().Err(err).Msg("failed to close bolt database")
+			}
+		})
+	case driverPostgres:
+		pool := postgres.GetPool(ctx, cfg.Postgres.DSN, log)
+		quotas := postgres.NewQuotaStorage(pool, cfg.Quotas.Default, opts, log)
+		boardStorage = postgres.NewStorage(pool, quotas, opts, log)
+		workspaceStorage = postgres.NewWorkspaceStorage(pool, opts, log)
+		quotaStorage = quotas
+		auditStorage = postgres.NewAuditStorage(pool, opts, log)
+		versionStorage = postgres.NewVersionStorage(pool, cfg.History.MaxVersions, cfg.History.MaxAge, opts, log)
+		idempotencyStorage = idempotencydb.NewPostgresStorage(ctx, pool, cfg.Idempotency.TTL, cfg.Storage.Timeout, log)
+
+		pings[driverPostgres] = pool.Ping
+		onClose = append(onClose, pool.Close)
+	default:
+		log.Fatal().Msgf("unknown storage driver %q", cfg.Storage.Driver)
+	}
+
+	if cmd := flag.Arg(0); cmd == cmdBackup || cmd == cmdRestore {
+		storages := snapshot.Storages{Boards: boardStorage, Workspaces: workspaceStorage, Audit: auditStorage, Versions: versionStorage}
+		if cmd == cmdBackup {
+			err = backup(ctx, storages, cfg.Storage.Driver, flag.Arg(1), os.Stdout, log)
+		} else {
+			err = restore(ctx, storages, flag.Args()[1:], os.Stdout)
+		}
+		for _, fn := range onClose {
+			fn()
+		}
+		if mongoDB != nil {
+			if err1 := mongoDB.Client().Disconnect(context.Background()); err1 != nil {
+				log.Error().Err(err1).Msg("failed to disconnect mongodb")
+			}
+		}
+		if err != nil {
+			log.Fatal().Err(err).Msgf("%s failed", cmd)
+		}
+		return
+	}
+
+	if stats, ok := boardStorage.(board.StatsStorage); ok {
+		prometheus.MustRegister(board.NewStatsCollector(ctx, stats, cfg.Metrics.StatsInterval, log))
+	}
+
+	var storage board.Storage = board.NewVersionStorage(
+		board.NewAuditStorage(boardStorage, auditStorage, log),
+		versionStorage,
+		log,
+	)
+
+	var redisClient *redis.Client
+	if len(cfg.Redis.Addr) > 0 {
+		redisClient = redis.NewClient(&redis.Options{
+			Addr:     cfg.Redis.Addr,
+			Password: cfg.Redis.Password,
+			DB:       cfg.Redis.DB,
+		})
+
+		pings[cacheRedis] = func(ctx context.Context) error {
+			return redisClient.Ping(ctx).Err()
+		}
+		onClose = append(onClose, func() {
+			if err := redisClient.Close(); err != nil {
+				log.Error().Err(err).Msg("failed to close redis client")
+			}
+		})
+	}
+
+	if cfg.Cache.Enabled {
+		var boardCache cache.Cache
+		var events cache.Events
+
+		switch cfg.Cache.Backend {
+		case cacheMemory:
+			boardCache = cache.NewMemory(cfg.Cache.Size, cfg.Cache.TTL)
+			if redisClient != nil {
+				events = cache.NewRedisEvents(redisClient, cfg.Cache.Channel, log)
+			} else {
+				log.Warn().Msg("redis is not configured, cache invalidation is not shared between replicas")
+			}
+		case cacheRedis:
+			if redisClient == nil {
+				log.Fatal().Msg("redis cache requires redis addr")
+			}
+			boardCache = cache.NewRedis(redisClient, cfg.Cache.Prefix, cfg.Cache.TTL)
+		default:
+			log.Fatal().Msgf("unknown cache backend %q", cfg.Cache.Backend)
+		}
 
-	mongoDB := mongodb.GetDB(ctx, cfg.MongoDB.URI, log)
-	storage := db.NewStorage(ctx, mongoDB, log)
+		cached := board.NewCacheStorage(storage, boardCache, events, cfg.Cache.MaxMemberBoards, log)
+		go cached.Listen(ctx)
 
-	register := func(server *grpc.Server) {
-		v1.RegisterBoardServer(server, board.NewBoardServer(storage))
+		storage = cached
+	}
+
+	// outermost, so that the cache is shared by every workspace
+	storage = board.NewTenantStorage(storage)
+	tenants := board.TenantResolver{Claim: cfg.Tenancy.Claim, Required: cfg.Tenancy.Required}
+
+	var limiter ratelimit.Limiter = ratelimit.NewLocalLimiter(cfg.RateLimit.Idle)
+	if cfg.RateLimit.Distributed {
+		if mongoDB == nil {
+			log.Fatal().Msg("distributed rate limits require mongodb")
+		}
+		limiter = ratelimitdb.NewLimiter(ctx, mongoDB, cfg.RateLimit.Idle, log)
+	}
+	policy := ratelimit.NewPolicy(rateLimits(cfg))
+	verifier, err := ratelimit.NewVerifier(cfg.Auth.JWTSecret, cfg.Auth.JWTPublicKey)
+	if err != nil {
+		log.Fatal().Err(err).Msg("jwt verifier")
+	}
+
+	// SIGHUP reloads the config at once, it does not stop the service
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+
+	go config.Watch(ctx, configFile, cfg.Reload.Interval, hup, log, func(c config.Config) error {
+		level := zerolog.GlobalLevel()
+		if len(c.Log.Level) > 0 {
+			var err error
+			if level, err = zerolog.ParseLevel(c.Log.Level); err != nil {
+				return err
+			}
+		}
+		v, err := board.NewValidator(c.Validation.Rules)
+		if err != nil {
+			return err
+		}
+
+		zerolog.SetGlobalLevel(level)
+		validatorRef.Store(v)
+		policy.Store(rateLimits(c))
+		quotaStorage.SetDefaults(c.Quotas.Default)
+
+		return nil
+	})
+
+	if len(cfg.Metrics.Addr) > 0 {
+		go metrics.Serve(ctx, cfg.Metrics.Addr, log)
 	}
 
 	lis, err := net.Listen("tcp", cfg.GRPC.Addr)
@@ -110,9 +402,115 @@ func main() {
 
 	log.Info().Msgf("bind application to addr: %s", lis.Addr().(*net.TCPAddr).String())
 
-	grpcserver.Start(ctx, lis, register, log, grpc.ChainUnaryInterceptor(
+	server := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		otelgrpc.UnaryServerInterceptor(),
+		metrics.UnaryServerInterceptor(),
+		ratelimit.UnaryServerInterceptor(limiter, policy, verifier, log),
 		mongodb.ErrorUnaryServerInterceptor(),
-		validate.DefaultValidatorUnaryServerInterceptor(),
+		board.TenantUnaryServerInterceptor(tenants),
+		board.ValidatorUnaryServerInterceptor(validatorRef),
+		idempotency.UnaryServerInterceptor(idempotencyStorage, idempotencyScope(cfg.RateLimit.Header, verifier), cfg.Idempotency.Lease, log, []string{
+			"/proto.v1.Board/CreateBoard",
+			"/proto.v1.Board/UpdateBoard",
+			"/proto.v1.Board/DeleteBoard",
+			"/proto.v1.Board/BatchCreateBoards",
+			"/proto.v1.Board/BatchUpdateBoards",
+			"/proto.v1.Board/BatchDeleteBoards",
+			"/proto.v1.Board/RevertBoard",
+			"/proto.v1.Board/TransferBoard",
+			"/proto.v1.Board/MoveBoard",
+			"/proto.v1.Board/CreateWorkspace",
+			"/proto.v1.Board/UpdateWorkspace",
+			"/proto.v1.Board/DeleteWorkspace",
+		}...),
 		grpc_recovery.UnaryServerInterceptor(),
+	), grpc.ChainStreamInterceptor(
+		otelgrpc.StreamServerInterceptor(),
+		metrics.StreamServerInterceptor(),
+		ratelimit.StreamServerInterceptor(limiter, policy, verifier, log),
+		mongodb.ErrorStreamServerInterceptor(),
+		board.TenantStreamServerInterceptor(tenants),
+		board.ValidatorStreamServerInterceptor(validatorRef),
+		grpc_recovery.StreamServerInterceptor(),
 	))
+
+	v1.RegisterBoardServer(server, board.NewBoardServer(storage, workspaceStorage, auditStorage, versionStorage, quotaStorage, validatorRef))
+
+	var onStop []func()
+
+	if cfg.GRPC.HealthCheck {
+		healthServer := health.NewServer()
+		grpc_health_v1.RegisterHealthServer(server, healthServer)
+
+		checker := healthcheck.NewChecker(healthServer, pings, cfg.GRPC.HealthInterval, log, v1.Board_ServiceDesc.ServiceName)
+		go checker.Run(ctx)
+
+		onStop = append(onStop, checker.Shutdown)
+	}
+
+	if cfg.GRPC.Reflection {
+		reflection.Register(server)
+	}
+
+	var wg sync.WaitGroup
+
+	if len(cfg.Gateway.Addr) > 0 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			gateway.Serve(ctx, cfg.Gateway.Addr, lis.Addr().String(), cfg.GRPC.DrainDelay, cfg.GRPC.ShutdownTimeout, log, cfg.RateLimit.Header)
+		}()
+	}
+
+	go func() {
+		<-ctx.Done()
+		// a second signal kills the process instead of waiting for the drain
+		stop()
+	}()
+
+	if err = srv.Serve(ctx, lis, server, cfg.GRPC.DrainDelay, cfg.GRPC.ShutdownTimeout, log, onStop...); err != nil {
+		log.Fatal().Err(err).Msg("unexpected error")
+	}
+
+	wg.Wait()
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.GRPC.ShutdownTimeout)
+	defer cancel()
+
+	if err = tracerProvider.Shutdown(shutdownCtx); err != nil {
+		log.Error().Err(err).Msg("failed to flush spans")
+	}
+
+	for _, fn := range onClose {
+		fn()
+	}
+
+	if mongoDB != nil {
+		if err = mongoDB.Client().Disconnect(shutdownCtx); err != nil {
+			log.Error().Err(err).Msg("failed to disconnect mongodb")
+		}
+	}
+
+	log.Info().Msg("goodbye.....")
+}
+
+// idempotencyScope keeps the idempotency keys of every caller and tenant
+// apart, the caller identified like for the rate limits.
+func idempotencyScope(header string, v *ratelimit.Verifier) idempotency.Scope {
+	return func(ctx context.Context) string {
+		t, _ := board.TenantFromContext(ctx)
+		return ratelimit.Caller(ctx, header, v) + "/" + strings.Join(t.WorkspaceIDs, ",")
+	}
+}
+
+func rateLimits(cfg config.Config) ratelimit.Config {
+	if !cfg.RateLimit.Enabled {
+		// without limits every method is unlimited
+		return ratelimit.Config{}
+	}
+	return ratelimit.Config{
+		Header:  cfg.RateLimit.Header,
+		Default: cfg.RateLimit.Default,
+		Methods: cfg.RateLimit.Methods,
+	}
 }
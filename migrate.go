@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-funcards/board-service/internal/board/db"
+	"io"
+	"text/tabwriter"
+	"time"
+)
+
+const (
+	migrateStatus = "status"
+	migrateUp     = "up"
+	migrateDryRun = "dry-run"
+)
+
+// migrate runs `board-service migrate [status|up|dry-run]`, status being the
+// default mode, and writes its report to out.
+func migrate(ctx context.Context, migrator *db.Migrator, mode string, out io.Writer) error {
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	defer w.Flush()
+
+	switch mode {
+	case "", migrateStatus:
+		data, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintln(w, "VERSION\tAPPLIED AT\tDURATION\tDESCRIPTION")
+		for _, item := range data {
+			appliedAt := "pending"
+			if item.Applied {
+				appliedAt = item.AppliedAt.Format(time.RFC3339)
+			}
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", item.Version, appliedAt, item.Duration, item.Description)
+		}
+	case migrateDryRun:
+		pending, err := migrator.Pending(ctx)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(w, "%d migration(s) would be applied\n", len(pending))
+		for _, item := range pending {
+			fmt.Fprintf(w, "%d\t%s\n", item.Version, item.Description)
+		}
+	case migrateUp:
+		done, err := migrator.Up(ctx)
+		for _, item := range done {
+			fmt.Fprintf(w, "%d\t%s\tapplied\n", item.Version, item.Description)
+		}
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(w, "%d migration(s) applied\n", len(done))
+	default:
+		return fmt.Errorf("unknown migrate mode %q, expected %s, %s or %s", mode, migrateStatus, migrateUp, migrateDryRun)
+	}
+
+	return nil
+}
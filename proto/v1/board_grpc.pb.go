@@ -26,7 +26,25 @@ type BoardClient interface {
 	CreateBoard(ctx context.Context, in *CreateBoardRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	UpdateBoard(ctx context.Context, in *UpdateBoardRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeleteBoard(ctx context.Context, in *DeleteBoardRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetBoard(ctx context.Context, in *BoardRequest, opts ...grpc.CallOption) (*BoardsResponse_Board, error)
 	GetBoards(ctx context.Context, in *BoardsRequest, opts ...grpc.CallOption) (*BoardsResponse, error)
+	BatchCreateBoards(ctx context.Context, in *BatchCreateBoardsRequest, opts ...grpc.CallOption) (*BatchBoardsResponse, error)
+	BatchUpdateBoards(ctx context.Context, in *BatchUpdateBoardsRequest, opts ...grpc.CallOption) (*BatchBoardsResponse, error)
+	BatchDeleteBoards(ctx context.Context, in *BatchDeleteBoardsRequest, opts ...grpc.CallOption) (*BatchBoardsResponse, error)
+	ListBoardAudit(ctx context.Context, in *BoardAuditRequest, opts ...grpc.CallOption) (*BoardAuditResponse, error)
+	ListBoardVersions(ctx context.Context, in *BoardVersionsRequest, opts ...grpc.CallOption) (*BoardVersionsResponse, error)
+	GetBoardVersion(ctx context.Context, in *BoardVersionRequest, opts ...grpc.CallOption) (*BoardVersionsResponse_Version, error)
+	RevertBoard(ctx context.Context, in *RevertBoardRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	TransferBoard(ctx context.Context, in *TransferBoardRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	MoveBoard(ctx context.Context, in *MoveBoardRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetUsage(ctx context.Context, in *UsageRequest, opts ...grpc.CallOption) (*UsageResponse, error)
+	ExportBoards(ctx context.Context, in *ExportBoardsRequest, opts ...grpc.CallOption) (Board_ExportBoardsClient, error)
+	ImportBoards(ctx context.Context, opts ...grpc.CallOption) (Board_ImportBoardsClient, error)
+	CreateWorkspace(ctx context.Context, in *CreateWorkspaceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UpdateWorkspace(ctx context.Context, in *UpdateWorkspaceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteWorkspace(ctx context.Context, in *DeleteWorkspaceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetWorkspace(ctx context.Context, in *WorkspaceRequest, opts ...grpc.CallOption) (*WorkspacesResponse_Workspace, error)
+	GetWorkspaces(ctx context.Context, in *WorkspacesRequest, opts ...grpc.CallOption) (*WorkspacesResponse, error)
 }
 
 type boardClient struct {
@@ -64,6 +82,15 @@ func (c *boardClient) DeleteBoard(ctx context.Context, in *DeleteBoardRequest, o
 	return out, nil
 }
 
+func (c *boardClient) GetBoard(ctx context.Context, in *BoardRequest, opts ...grpc.CallOption) (*BoardsResponse_Board, error) {
+	out := new(BoardsResponse_Board)
+	err := c.cc.Invoke(ctx, "/proto.v1.Board/GetBoard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *boardClient) GetBoards(ctx context.Context, in *BoardsRequest, opts ...grpc.CallOption) (*BoardsResponse, error) {
 	out := new(BoardsResponse)
 	err := c.cc.Invoke(ctx, "/proto.v1.Board/GetBoards", in, out, opts...)
@@ -73,6 +100,207 @@ func (c *boardClient) GetBoards(ctx context.Context, in *BoardsRequest, opts ...
 	return out, nil
 }
 
+func (c *boardClient) BatchCreateBoards(ctx context.Context, in *BatchCreateBoardsRequest, opts ...grpc.CallOption) (*BatchBoardsResponse, error) {
+	out := new(BatchBoardsResponse)
+	err := c.cc.Invoke(ctx, "/proto.v1.Board/BatchCreateBoards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardClient) BatchUpdateBoards(ctx context.Context, in *BatchUpdateBoardsRequest, opts ...grpc.CallOption) (*BatchBoardsResponse, error) {
+	out := new(BatchBoardsResponse)
+	err := c.cc.Invoke(ctx, "/proto.v1.Board/BatchUpdateBoards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardClient) BatchDeleteBoards(ctx context.Context, in *BatchDeleteBoardsRequest, opts ...grpc.CallOption) (*BatchBoardsResponse, error) {
+	out := new(BatchBoardsResponse)
+	err := c.cc.Invoke(ctx, "/proto.v1.Board/BatchDeleteBoards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardClient) ListBoardAudit(ctx context.Context, in *BoardAuditRequest, opts ...grpc.CallOption) (*BoardAuditResponse, error) {
+	out := new(BoardAuditResponse)
+	err := c.cc.Invoke(ctx, "/proto.v1.Board/ListBoardAudit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardClient) ListBoardVersions(ctx context.Context, in *BoardVersionsRequest, opts ...grpc.CallOption) (*BoardVersionsResponse, error) {
+	out := new(BoardVersionsResponse)
+	err := c.cc.Invoke(ctx, "/proto.v1.Board/ListBoardVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardClient) GetBoardVersion(ctx context.Context, in *BoardVersionRequest, opts ...grpc.CallOption) (*BoardVersionsResponse_Version, error) {
+	out := new(BoardVersionsResponse_Version)
+	err := c.cc.Invoke(ctx, "/proto.v1.Board/GetBoardVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardClient) RevertBoard(ctx context.Context, in *RevertBoardRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/proto.v1.Board/RevertBoard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardClient) TransferBoard(ctx context.Context, in *TransferBoardRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/proto.v1.Board/TransferBoard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardClient) MoveBoard(ctx context.Context, in *MoveBoardRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/proto.v1.Board/MoveBoard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardClient) GetUsage(ctx context.Context, in *UsageRequest, opts ...grpc.CallOption) (*UsageResponse, error) {
+	out := new(UsageResponse)
+	err := c.cc.Invoke(ctx, "/proto.v1.Board/GetUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardClient) ExportBoards(ctx context.Context, in *ExportBoardsRequest, opts ...grpc.CallOption) (Board_ExportBoardsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Board_ServiceDesc.Streams[0], "/proto.v1.Board/ExportBoards", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &boardExportBoardsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Board_ExportBoardsClient interface {
+	Recv() (*ExportChunk, error)
+	grpc.ClientStream
+}
+
+type boardExportBoardsClient struct {
+	grpc.ClientStream
+}
+
+func (x *boardExportBoardsClient) Recv() (*ExportChunk, error) {
+	m := new(ExportChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *boardClient) ImportBoards(ctx context.Context, opts ...grpc.CallOption) (Board_ImportBoardsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Board_ServiceDesc.Streams[1], "/proto.v1.Board/ImportBoards", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &boardImportBoardsClient{stream}
+	return x, nil
+}
+
+type Board_ImportBoardsClient interface {
+	Send(*ImportChunk) error
+	CloseAndRecv() (*ImportBoardsResponse, error)
+	grpc.ClientStream
+}
+
+type boardImportBoardsClient struct {
+	grpc.ClientStream
+}
+
+func (x *boardImportBoardsClient) Send(m *ImportChunk) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *boardImportBoardsClient) CloseAndRecv() (*ImportBoardsResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportBoardsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *boardClient) CreateWorkspace(ctx context.Context, in *CreateWorkspaceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/proto.v1.Board/CreateWorkspace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardClient) UpdateWorkspace(ctx context.Context, in *UpdateWorkspaceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/proto.v1.Board/UpdateWorkspace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardClient) DeleteWorkspace(ctx context.Context, in *DeleteWorkspaceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/proto.v1.Board/DeleteWorkspace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardClient) GetWorkspace(ctx context.Context, in *WorkspaceRequest, opts ...grpc.CallOption) (*WorkspacesResponse_Workspace, error) {
+	out := new(WorkspacesResponse_Workspace)
+	err := c.cc.Invoke(ctx, "/proto.v1.Board/GetWorkspace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardClient) GetWorkspaces(ctx context.Context, in *WorkspacesRequest, opts ...grpc.CallOption) (*WorkspacesResponse, error) {
+	out := new(WorkspacesResponse)
+	err := c.cc.Invoke(ctx, "/proto.v1.Board/GetWorkspaces", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BoardServer is the server API for Board service.
 // All implementations must embed UnimplementedBoardServer
 // for forward compatibility
@@ -80,7 +308,25 @@ type BoardServer interface {
 	CreateBoard(context.Context, *CreateBoardRequest) (*emptypb.Empty, error)
 	UpdateBoard(context.Context, *UpdateBoardRequest) (*emptypb.Empty, error)
 	DeleteBoard(context.Context, *DeleteBoardRequest) (*emptypb.Empty, error)
+	GetBoard(context.Context, *BoardRequest) (*BoardsResponse_Board, error)
 	GetBoards(context.Context, *BoardsRequest) (*BoardsResponse, error)
+	BatchCreateBoards(context.Context, *BatchCreateBoardsRequest) (*BatchBoardsResponse, error)
+	BatchUpdateBoards(context.Context, *BatchUpdateBoardsRequest) (*BatchBoardsResponse, error)
+	BatchDeleteBoards(context.Context, *BatchDeleteBoardsRequest) (*BatchBoardsResponse, error)
+	ListBoardAudit(context.Context, *BoardAuditRequest) (*BoardAuditResponse, error)
+	ListBoardVersions(context.Context, *BoardVersionsRequest) (*BoardVersionsResponse, error)
+	GetBoardVersion(context.Context, *BoardVersionRequest) (*BoardVersionsResponse_Version, error)
+	RevertBoard(context.Context, *RevertBoardRequest) (*emptypb.Empty, error)
+	TransferBoard(context.Context, *TransferBoardRequest) (*emptypb.Empty, error)
+	MoveBoard(context.Context, *MoveBoardRequest) (*emptypb.Empty, error)
+	GetUsage(context.Context, *UsageRequest) (*UsageResponse, error)
+	ExportBoards(*ExportBoardsRequest, Board_ExportBoardsServer) error
+	ImportBoards(Board_ImportBoardsServer) error
+	CreateWorkspace(context.Context, *CreateWorkspaceRequest) (*emptypb.Empty, error)
+	UpdateWorkspace(context.Context, *UpdateWorkspaceRequest) (*emptypb.Empty, error)
+	DeleteWorkspace(context.Context, *DeleteWorkspaceRequest) (*emptypb.Empty, error)
+	GetWorkspace(context.Context, *WorkspaceRequest) (*WorkspacesResponse_Workspace, error)
+	GetWorkspaces(context.Context, *WorkspacesRequest) (*WorkspacesResponse, error)
 	mustEmbedUnimplementedBoardServer()
 }
 
@@ -97,9 +343,63 @@ func (UnimplementedBoardServer) UpdateBoard(context.Context, *UpdateBoardRequest
 func (UnimplementedBoardServer) DeleteBoard(context.Context, *DeleteBoardRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteBoard not implemented")
 }
+func (UnimplementedBoardServer) GetBoard(context.Context, *BoardRequest) (*BoardsResponse_Board, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBoard not implemented")
+}
 func (UnimplementedBoardServer) GetBoards(context.Context, *BoardsRequest) (*BoardsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBoards not implemented")
 }
+func (UnimplementedBoardServer) BatchCreateBoards(context.Context, *BatchCreateBoardsRequest) (*BatchBoardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateBoards not implemented")
+}
+func (UnimplementedBoardServer) BatchUpdateBoards(context.Context, *BatchUpdateBoardsRequest) (*BatchBoardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateBoards not implemented")
+}
+func (UnimplementedBoardServer) BatchDeleteBoards(context.Context, *BatchDeleteBoardsRequest) (*BatchBoardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteBoards not implemented")
+}
+func (UnimplementedBoardServer) ListBoardAudit(context.Context, *BoardAuditRequest) (*BoardAuditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBoardAudit not implemented")
+}
+func (UnimplementedBoardServer) ListBoardVersions(context.Context, *BoardVersionsRequest) (*BoardVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBoardVersions not implemented")
+}
+func (UnimplementedBoardServer) GetBoardVersion(context.Context, *BoardVersionRequest) (*BoardVersionsResponse_Version, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBoardVersion not implemented")
+}
+func (UnimplementedBoardServer) RevertBoard(context.Context, *RevertBoardRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertBoard not implemented")
+}
+func (UnimplementedBoardServer) TransferBoard(context.Context, *TransferBoardRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferBoard not implemented")
+}
+func (UnimplementedBoardServer) MoveBoard(context.Context, *MoveBoardRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveBoard not implemented")
+}
+func (UnimplementedBoardServer) GetUsage(context.Context, *UsageRequest) (*UsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsage not implemented")
+}
+func (UnimplementedBoardServer) ExportBoards(*ExportBoardsRequest, Board_ExportBoardsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportBoards not implemented")
+}
+func (UnimplementedBoardServer) ImportBoards(Board_ImportBoardsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportBoards not implemented")
+}
+func (UnimplementedBoardServer) CreateWorkspace(context.Context, *CreateWorkspaceRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWorkspace not implemented")
+}
+func (UnimplementedBoardServer) UpdateWorkspace(context.Context, *UpdateWorkspaceRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkspace not implemented")
+}
+func (UnimplementedBoardServer) DeleteWorkspace(context.Context, *DeleteWorkspaceRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorkspace not implemented")
+}
+func (UnimplementedBoardServer) GetWorkspace(context.Context, *WorkspaceRequest) (*WorkspacesResponse_Workspace, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkspace not implemented")
+}
+func (UnimplementedBoardServer) GetWorkspaces(context.Context, *WorkspacesRequest) (*WorkspacesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkspaces not implemented")
+}
 func (UnimplementedBoardServer) mustEmbedUnimplementedBoardServer() {}
 
 // UnsafeBoardServer may be embedded to opt out of forward compatibility for this service.
@@ -167,6 +467,24 @@ func _Board_DeleteBoard_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Board_GetBoard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BoardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServer).GetBoard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.v1.Board/GetBoard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServer).GetBoard(ctx, req.(*BoardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Board_GetBoards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BoardsRequest)
 	if err := dec(in); err != nil {
@@ -185,6 +503,323 @@ func _Board_GetBoards_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Board_BatchCreateBoards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateBoardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServer).BatchCreateBoards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.v1.Board/BatchCreateBoards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServer).BatchCreateBoards(ctx, req.(*BatchCreateBoardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Board_BatchUpdateBoards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateBoardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServer).BatchUpdateBoards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.v1.Board/BatchUpdateBoards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServer).BatchUpdateBoards(ctx, req.(*BatchUpdateBoardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Board_BatchDeleteBoards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteBoardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServer).BatchDeleteBoards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.v1.Board/BatchDeleteBoards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServer).BatchDeleteBoards(ctx, req.(*BatchDeleteBoardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Board_ListBoardAudit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BoardAuditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServer).ListBoardAudit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.v1.Board/ListBoardAudit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServer).ListBoardAudit(ctx, req.(*BoardAuditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Board_ListBoardVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BoardVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServer).ListBoardVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.v1.Board/ListBoardVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServer).ListBoardVersions(ctx, req.(*BoardVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Board_GetBoardVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BoardVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServer).GetBoardVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.v1.Board/GetBoardVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServer).GetBoardVersion(ctx, req.(*BoardVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Board_RevertBoard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertBoardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServer).RevertBoard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.v1.Board/RevertBoard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServer).RevertBoard(ctx, req.(*RevertBoardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Board_TransferBoard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferBoardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServer).TransferBoard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.v1.Board/TransferBoard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServer).TransferBoard(ctx, req.(*TransferBoardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Board_MoveBoard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveBoardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServer).MoveBoard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.v1.Board/MoveBoard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServer).MoveBoard(ctx, req.(*MoveBoardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Board_GetUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServer).GetUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.v1.Board/GetUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServer).GetUsage(ctx, req.(*UsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Board_ExportBoards_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportBoardsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BoardServer).ExportBoards(m, &boardExportBoardsServer{stream})
+}
+
+type Board_ExportBoardsServer interface {
+	Send(*ExportChunk) error
+	grpc.ServerStream
+}
+
+type boardExportBoardsServer struct {
+	grpc.ServerStream
+}
+
+func (x *boardExportBoardsServer) Send(m *ExportChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Board_ImportBoards_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(BoardServer).ImportBoards(&boardImportBoardsServer{stream})
+}
+
+type Board_ImportBoardsServer interface {
+	SendAndClose(*ImportBoardsResponse) error
+	Recv() (*ImportChunk, error)
+	grpc.ServerStream
+}
+
+type boardImportBoardsServer struct {
+	grpc.ServerStream
+}
+
+func (x *boardImportBoardsServer) SendAndClose(m *ImportBoardsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *boardImportBoardsServer) Recv() (*ImportChunk, error) {
+	m := new(ImportChunk)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Board_CreateWorkspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWorkspaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServer).CreateWorkspace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.v1.Board/CreateWorkspace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServer).CreateWorkspace(ctx, req.(*CreateWorkspaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Board_UpdateWorkspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWorkspaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServer).UpdateWorkspace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.v1.Board/UpdateWorkspace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServer).UpdateWorkspace(ctx, req.(*UpdateWorkspaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Board_DeleteWorkspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWorkspaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServer).DeleteWorkspace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.v1.Board/DeleteWorkspace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServer).DeleteWorkspace(ctx, req.(*DeleteWorkspaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Board_GetWorkspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkspaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServer).GetWorkspace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.v1.Board/GetWorkspace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServer).GetWorkspace(ctx, req.(*WorkspaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Board_GetWorkspaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkspacesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServer).GetWorkspaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.v1.Board/GetWorkspaces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServer).GetWorkspaces(ctx, req.(*WorkspacesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Board_ServiceDesc is the grpc.ServiceDesc for Board service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -204,11 +839,86 @@ var Board_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteBoard",
 			Handler:    _Board_DeleteBoard_Handler,
 		},
+		{
+			MethodName: "GetBoard",
+			Handler:    _Board_GetBoard_Handler,
+		},
 		{
 			MethodName: "GetBoards",
 			Handler:    _Board_GetBoards_Handler,
 		},
+		{
+			MethodName: "BatchCreateBoards",
+			Handler:    _Board_BatchCreateBoards_Handler,
+		},
+		{
+			MethodName: "BatchUpdateBoards",
+			Handler:    _Board_BatchUpdateBoards_Handler,
+		},
+		{
+			MethodName: "BatchDeleteBoards",
+			Handler:    _Board_BatchDeleteBoards_Handler,
+		},
+		{
+			MethodName: "ListBoardAudit",
+			Handler:    _Board_ListBoardAudit_Handler,
+		},
+		{
+			MethodName: "ListBoardVersions",
+			Handler:    _Board_ListBoardVersions_Handler,
+		},
+		{
+			MethodName: "GetBoardVersion",
+			Handler:    _Board_GetBoardVersion_Handler,
+		},
+		{
+			MethodName: "RevertBoard",
+			Handler:    _Board_RevertBoard_Handler,
+		},
+		{
+			MethodName: "TransferBoard",
+			Handler:    _Board_TransferBoard_Handler,
+		},
+		{
+			MethodName: "MoveBoard",
+			Handler:    _Board_MoveBoard_Handler,
+		},
+		{
+			MethodName: "GetUsage",
+			Handler:    _Board_GetUsage_Handler,
+		},
+		{
+			MethodName: "CreateWorkspace",
+			Handler:    _Board_CreateWorkspace_Handler,
+		},
+		{
+			MethodName: "UpdateWorkspace",
+			Handler:    _Board_UpdateWorkspace_Handler,
+		},
+		{
+			MethodName: "DeleteWorkspace",
+			Handler:    _Board_DeleteWorkspace_Handler,
+		},
+		{
+			MethodName: "GetWorkspace",
+			Handler:    _Board_GetWorkspace_Handler,
+		},
+		{
+			MethodName: "GetWorkspaces",
+			Handler:    _Board_GetWorkspaces_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportBoards",
+			Handler:       _Board_ExportBoards_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportBoards",
+			Handler:       _Board_ImportBoards_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/board.proto",
 }